@@ -0,0 +1,886 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.9
+// source: proto/admin.proto
+
+package admin
+
+import (
+	converter "github.com/binance-converter/backend-api/api/converter"
+	currencies "github.com/binance-converter/backend-api/api/currencies"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EFeeScope int32
+
+const (
+	EFeeScope_BANK     EFeeScope = 0
+	EFeeScope_PROVIDER EFeeScope = 1
+)
+
+// Enum value maps for EFeeScope.
+var (
+	EFeeScope_name = map[int32]string{
+		0: "BANK",
+		1: "PROVIDER",
+	}
+	EFeeScope_value = map[string]int32{
+		"BANK":     0,
+		"PROVIDER": 1,
+	}
+)
+
+func (x EFeeScope) Enum() *EFeeScope {
+	p := new(EFeeScope)
+	*p = x
+	return p
+}
+
+func (x EFeeScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EFeeScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (EFeeScope) Type() protoreflect.EnumType {
+	return &file_proto_admin_proto_enumTypes[0]
+}
+
+func (x EFeeScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EFeeScope.Descriptor instead.
+func (EFeeScope) EnumDescriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type AdditionalErrorCode int32
+
+const (
+	AdditionalErrorCode_OK                          AdditionalErrorCode = 0
+	AdditionalErrorCode_INVALID_FEE_SCOPE           AdditionalErrorCode = 100
+	AdditionalErrorCode_INVALID_FEE_KEY             AdditionalErrorCode = 101
+	AdditionalErrorCode_INVALID_FEE                 AdditionalErrorCode = 102
+	AdditionalErrorCode_FEE_NOT_FOUND               AdditionalErrorCode = 103
+	AdditionalErrorCode_INVALID_CURRENCY            AdditionalErrorCode = 104
+	AdditionalErrorCode_CURRENCY_NOT_FOUND          AdditionalErrorCode = 105
+	AdditionalErrorCode_INVALID_CONVERTER_PAIR      AdditionalErrorCode = 106
+	AdditionalErrorCode_CONVERTER_PAIR_NOT_FOUND    AdditionalErrorCode = 107
+	AdditionalErrorCode_CONVERTER_PAIR_NOT_QUOTABLE AdditionalErrorCode = 108
+)
+
+// Enum value maps for AdditionalErrorCode.
+var (
+	AdditionalErrorCode_name = map[int32]string{
+		0:   "OK",
+		100: "INVALID_FEE_SCOPE",
+		101: "INVALID_FEE_KEY",
+		102: "INVALID_FEE",
+		103: "FEE_NOT_FOUND",
+		104: "INVALID_CURRENCY",
+		105: "CURRENCY_NOT_FOUND",
+		106: "INVALID_CONVERTER_PAIR",
+		107: "CONVERTER_PAIR_NOT_FOUND",
+		108: "CONVERTER_PAIR_NOT_QUOTABLE",
+	}
+	AdditionalErrorCode_value = map[string]int32{
+		"OK":                          0,
+		"INVALID_FEE_SCOPE":           100,
+		"INVALID_FEE_KEY":             101,
+		"INVALID_FEE":                 102,
+		"FEE_NOT_FOUND":               103,
+		"INVALID_CURRENCY":            104,
+		"CURRENCY_NOT_FOUND":          105,
+		"INVALID_CONVERTER_PAIR":      106,
+		"CONVERTER_PAIR_NOT_FOUND":    107,
+		"CONVERTER_PAIR_NOT_QUOTABLE": 108,
+	}
+)
+
+func (x AdditionalErrorCode) Enum() *AdditionalErrorCode {
+	p := new(AdditionalErrorCode)
+	*p = x
+	return p
+}
+
+func (x AdditionalErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdditionalErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (AdditionalErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_admin_proto_enumTypes[1]
+}
+
+func (x AdditionalErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdditionalErrorCode.Descriptor instead.
+func (AdditionalErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type FeeScheduleKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope EFeeScope `protobuf:"varint,1,opt,name=scope,proto3,enum=binance_converter.backend.admin.EFeeScope" json:"scope,omitempty"`
+	// bank code for bank fees and provider name for provider fees
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *FeeScheduleKey) Reset() {
+	*x = FeeScheduleKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeScheduleKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeScheduleKey) ProtoMessage() {}
+
+func (x *FeeScheduleKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeScheduleKey.ProtoReflect.Descriptor instead.
+func (*FeeScheduleKey) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeeScheduleKey) GetScope() EFeeScope {
+	if x != nil {
+		return x.Scope
+	}
+	return EFeeScope_BANK
+}
+
+func (x *FeeScheduleKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type FeeSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *FeeScheduleKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// fixed fee charged in classic currency of a leg
+	Fixed float64 `protobuf:"fixed64,2,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	// percent of amount received on a leg
+	Percent float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	// exact decimal values of fees above, they are used instead of float ones when they are set
+	FixedDecimal   string `protobuf:"bytes,4,opt,name=fixedDecimal,proto3" json:"fixedDecimal,omitempty"`
+	PercentDecimal string `protobuf:"bytes,5,opt,name=percentDecimal,proto3" json:"percentDecimal,omitempty"`
+	// percent the exchange of a leg is worsened by for the side of the trade
+	Spread        float64 `protobuf:"fixed64,6,opt,name=spread,proto3" json:"spread,omitempty"`
+	SpreadDecimal string  `protobuf:"bytes,7,opt,name=spreadDecimal,proto3" json:"spreadDecimal,omitempty"`
+}
+
+func (x *FeeSchedule) Reset() {
+	*x = FeeSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSchedule) ProtoMessage() {}
+
+func (x *FeeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeSchedule.ProtoReflect.Descriptor instead.
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeSchedule) GetKey() *FeeScheduleKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *FeeSchedule) GetFixed() float64 {
+	if x != nil {
+		return x.Fixed
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetFixedDecimal() string {
+	if x != nil {
+		return x.FixedDecimal
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetPercentDecimal() string {
+	if x != nil {
+		return x.PercentDecimal
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetSpreadDecimal() string {
+	if x != nil {
+		return x.SpreadDecimal
+	}
+	return ""
+}
+
+type FeeSchedules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*FeeSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *FeeSchedules) Reset() {
+	*x = FeeSchedules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSchedules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSchedules) ProtoMessage() {}
+
+func (x *FeeSchedules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeSchedules.ProtoReflect.Descriptor instead.
+func (*FeeSchedules) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeeSchedules) GetSchedules() []*FeeSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+// catalogCurrency is a currency or a bank of classic currency known to the service
+type CatalogCurrency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency *currencies.FullCurrency `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	// disabled currencies and converter pairs using them are hidden from users
+	Disabled bool `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *CatalogCurrency) Reset() {
+	*x = CatalogCurrency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogCurrency) ProtoMessage() {}
+
+func (x *CatalogCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogCurrency.ProtoReflect.Descriptor instead.
+func (*CatalogCurrency) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CatalogCurrency) GetCurrency() *currencies.FullCurrency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+func (x *CatalogCurrency) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type CatalogCurrencies struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currencies []*CatalogCurrency `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+}
+
+func (x *CatalogCurrencies) Reset() {
+	*x = CatalogCurrencies{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogCurrencies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogCurrencies) ProtoMessage() {}
+
+func (x *CatalogCurrencies) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogCurrencies.ProtoReflect.Descriptor instead.
+func (*CatalogCurrencies) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CatalogCurrencies) GetCurrencies() []*CatalogCurrency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+type CatalogConverterPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair *converter.ConverterPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// disabled converter pairs are hidden from users
+	Disabled bool `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *CatalogConverterPair) Reset() {
+	*x = CatalogConverterPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogConverterPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogConverterPair) ProtoMessage() {}
+
+func (x *CatalogConverterPair) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogConverterPair.ProtoReflect.Descriptor instead.
+func (*CatalogConverterPair) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CatalogConverterPair) GetPair() *converter.ConverterPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *CatalogConverterPair) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type CatalogConverterPairs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*CatalogConverterPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *CatalogConverterPairs) Reset() {
+	*x = CatalogConverterPairs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogConverterPairs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogConverterPairs) ProtoMessage() {}
+
+func (x *CatalogConverterPairs) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogConverterPairs.ProtoReflect.Descriptor instead.
+func (*CatalogConverterPairs) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CatalogConverterPairs) GetPairs() []*CatalogConverterPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+var File_proto_admin_proto protoreflect.FileDescriptor
+
+var file_proto_admin_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x64, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2a, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x65, 0x46, 0x65, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x8a, 0x02, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x66, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x26, 0x0a,
+	0x0e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x44, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x22, 0x5a, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x66, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0x81, 0x01, 0x0a, 0x0f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x52, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x2e, 0x66, 0x75, 0x6c, 0x6c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x22, 0x65, 0x0a, 0x11, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x63,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x7e, 0x0a, 0x14, 0x63, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61,
+	0x69, 0x72, 0x12, 0x4a, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x15, 0x63, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x2a, 0x23, 0x0a, 0x09, 0x65, 0x46, 0x65, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x42, 0x41, 0x4e, 0x4b, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x56, 0x49,
+	0x44, 0x45, 0x52, 0x10, 0x01, 0x2a, 0xf6, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x46, 0x45, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x10, 0x64, 0x12, 0x13, 0x0a, 0x0f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x10,
+	0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x46, 0x45, 0x45,
+	0x10, 0x66, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x45, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x67, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x68, 0x12, 0x16, 0x0a, 0x12, 0x43,
+	0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x69, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43,
+	0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x10, 0x6a, 0x12,
+	0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x49,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x6b, 0x12, 0x1f, 0x0a,
+	0x1b, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x6c, 0x32, 0xb1,
+	0x08, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x56, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x46,
+	0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x66, 0x65, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x5c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2f, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x66, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x58,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2d, 0x2e, 0x62, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x66, 0x65, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x2e, 0x66, 0x75, 0x6c, 0x6c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5f, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x30,
+	0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x60, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x36, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x66, 0x75, 0x6c, 0x6c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5b, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x32, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x12, 0x36, 0x2e, 0x62, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50,
+	0x61, 0x69, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x69, 0x0a, 0x18, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x35, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x65, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x12, 0x36, 0x2e,
+	0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x63, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69,
+	0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x36, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x63, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69,
+	0x72, 0x73, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_admin_proto_rawDescOnce sync.Once
+	file_proto_admin_proto_rawDescData = file_proto_admin_proto_rawDesc
+)
+
+func file_proto_admin_proto_rawDescGZIP() []byte {
+	file_proto_admin_proto_rawDescOnce.Do(func() {
+		file_proto_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_admin_proto_rawDescData)
+	})
+	return file_proto_admin_proto_rawDescData
+}
+
+var file_proto_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_admin_proto_goTypes = []interface{}{
+	(EFeeScope)(0),                  // 0: binance_converter.backend.admin.eFeeScope
+	(AdditionalErrorCode)(0),        // 1: binance_converter.backend.admin.AdditionalErrorCode
+	(*FeeScheduleKey)(nil),          // 2: binance_converter.backend.admin.feeScheduleKey
+	(*FeeSchedule)(nil),             // 3: binance_converter.backend.admin.feeSchedule
+	(*FeeSchedules)(nil),            // 4: binance_converter.backend.admin.feeSchedules
+	(*CatalogCurrency)(nil),         // 5: binance_converter.backend.admin.catalogCurrency
+	(*CatalogCurrencies)(nil),       // 6: binance_converter.backend.admin.catalogCurrencies
+	(*CatalogConverterPair)(nil),    // 7: binance_converter.backend.admin.catalogConverterPair
+	(*CatalogConverterPairs)(nil),   // 8: binance_converter.backend.admin.catalogConverterPairs
+	(*currencies.FullCurrency)(nil), // 9: binance_converter.backend_api.currencies.fullCurrency
+	(*converter.ConverterPair)(nil), // 10: binance_converter.backend_api.converter.converterPair
+	(*emptypb.Empty)(nil),           // 11: google.protobuf.Empty
+}
+var file_proto_admin_proto_depIdxs = []int32{
+	0,  // 0: binance_converter.backend.admin.feeScheduleKey.scope:type_name -> binance_converter.backend.admin.eFeeScope
+	2,  // 1: binance_converter.backend.admin.feeSchedule.key:type_name -> binance_converter.backend.admin.feeScheduleKey
+	3,  // 2: binance_converter.backend.admin.feeSchedules.schedules:type_name -> binance_converter.backend.admin.feeSchedule
+	9,  // 3: binance_converter.backend.admin.catalogCurrency.currency:type_name -> binance_converter.backend_api.currencies.fullCurrency
+	5,  // 4: binance_converter.backend.admin.catalogCurrencies.currencies:type_name -> binance_converter.backend.admin.catalogCurrency
+	10, // 5: binance_converter.backend.admin.catalogConverterPair.pair:type_name -> binance_converter.backend_api.converter.converterPair
+	7,  // 6: binance_converter.backend.admin.catalogConverterPairs.pairs:type_name -> binance_converter.backend.admin.catalogConverterPair
+	3,  // 7: binance_converter.backend.admin.admin.SetFeeSchedule:input_type -> binance_converter.backend.admin.feeSchedule
+	2,  // 8: binance_converter.backend.admin.admin.DeleteFeeSchedule:input_type -> binance_converter.backend.admin.feeScheduleKey
+	11, // 9: binance_converter.backend.admin.admin.GetFeeSchedules:input_type -> google.protobuf.Empty
+	9,  // 10: binance_converter.backend.admin.admin.AddCurrency:input_type -> binance_converter.backend_api.currencies.fullCurrency
+	5,  // 11: binance_converter.backend.admin.admin.SetCurrencyDisabled:input_type -> binance_converter.backend.admin.catalogCurrency
+	9,  // 12: binance_converter.backend.admin.admin.DeleteCurrency:input_type -> binance_converter.backend_api.currencies.fullCurrency
+	11, // 13: binance_converter.backend.admin.admin.GetCurrencies:input_type -> google.protobuf.Empty
+	10, // 14: binance_converter.backend.admin.admin.AddConverterPair:input_type -> binance_converter.backend_api.converter.converterPair
+	7,  // 15: binance_converter.backend.admin.admin.SetConverterPairDisabled:input_type -> binance_converter.backend.admin.catalogConverterPair
+	10, // 16: binance_converter.backend.admin.admin.DeleteConverterPair:input_type -> binance_converter.backend_api.converter.converterPair
+	11, // 17: binance_converter.backend.admin.admin.GetConverterPairs:input_type -> google.protobuf.Empty
+	11, // 18: binance_converter.backend.admin.admin.SetFeeSchedule:output_type -> google.protobuf.Empty
+	11, // 19: binance_converter.backend.admin.admin.DeleteFeeSchedule:output_type -> google.protobuf.Empty
+	4,  // 20: binance_converter.backend.admin.admin.GetFeeSchedules:output_type -> binance_converter.backend.admin.feeSchedules
+	11, // 21: binance_converter.backend.admin.admin.AddCurrency:output_type -> google.protobuf.Empty
+	11, // 22: binance_converter.backend.admin.admin.SetCurrencyDisabled:output_type -> google.protobuf.Empty
+	11, // 23: binance_converter.backend.admin.admin.DeleteCurrency:output_type -> google.protobuf.Empty
+	6,  // 24: binance_converter.backend.admin.admin.GetCurrencies:output_type -> binance_converter.backend.admin.catalogCurrencies
+	11, // 25: binance_converter.backend.admin.admin.AddConverterPair:output_type -> google.protobuf.Empty
+	11, // 26: binance_converter.backend.admin.admin.SetConverterPairDisabled:output_type -> google.protobuf.Empty
+	11, // 27: binance_converter.backend.admin.admin.DeleteConverterPair:output_type -> google.protobuf.Empty
+	8,  // 28: binance_converter.backend.admin.admin.GetConverterPairs:output_type -> binance_converter.backend.admin.catalogConverterPairs
+	18, // [18:29] is the sub-list for method output_type
+	7,  // [7:18] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_admin_proto_init() }
+func file_proto_admin_proto_init() {
+	if File_proto_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeScheduleKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSchedules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogCurrency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogCurrencies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogConverterPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogConverterPairs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_admin_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_admin_proto_goTypes,
+		DependencyIndexes: file_proto_admin_proto_depIdxs,
+		EnumInfos:         file_proto_admin_proto_enumTypes,
+		MessageInfos:      file_proto_admin_proto_msgTypes,
+	}.Build()
+	File_proto_admin_proto = out.File
+	file_proto_admin_proto_rawDesc = nil
+	file_proto_admin_proto_goTypes = nil
+	file_proto_admin_proto_depIdxs = nil
+}
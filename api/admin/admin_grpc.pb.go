@@ -0,0 +1,480 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.9
+// source: proto/admin.proto
+
+package admin
+
+import (
+	context "context"
+	converter "github.com/binance-converter/backend-api/api/converter"
+	currencies "github.com/binance-converter/backend-api/api/currencies"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	SetFeeSchedule(ctx context.Context, in *FeeSchedule, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteFeeSchedule(ctx context.Context, in *FeeScheduleKey, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetFeeSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FeeSchedules, error)
+	// AddCurrency adds the currency or enables it when it already exists, a bank is added as
+	// classic currency with the bank code
+	AddCurrency(ctx context.Context, in *currencies.FullCurrency, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetCurrencyDisabled(ctx context.Context, in *CatalogCurrency, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteCurrency deletes the currency with converter pairs and subscriptions of users using it
+	DeleteCurrency(ctx context.Context, in *currencies.FullCurrency, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCurrencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogCurrencies, error)
+	// AddConverterPair adds the converter pair with its missing currencies and enables it, the pair
+	// must be quotable
+	AddConverterPair(ctx context.Context, in *converter.ConverterPair, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetConverterPairDisabled enables the converter pair only when it is quotable
+	SetConverterPairDisabled(ctx context.Context, in *CatalogConverterPair, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteConverterPair(ctx context.Context, in *converter.ConverterPair, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetConverterPairs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogConverterPairs, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) SetFeeSchedule(ctx context.Context, in *FeeSchedule, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/SetFeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteFeeSchedule(ctx context.Context, in *FeeScheduleKey, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/DeleteFeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetFeeSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FeeSchedules, error) {
+	out := new(FeeSchedules)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/GetFeeSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddCurrency(ctx context.Context, in *currencies.FullCurrency, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/AddCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetCurrencyDisabled(ctx context.Context, in *CatalogCurrency, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/SetCurrencyDisabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteCurrency(ctx context.Context, in *currencies.FullCurrency, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/DeleteCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetCurrencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogCurrencies, error) {
+	out := new(CatalogCurrencies)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/GetCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddConverterPair(ctx context.Context, in *converter.ConverterPair, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/AddConverterPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetConverterPairDisabled(ctx context.Context, in *CatalogConverterPair, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/SetConverterPairDisabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteConverterPair(ctx context.Context, in *converter.ConverterPair, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/DeleteConverterPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetConverterPairs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogConverterPairs, error) {
+	out := new(CatalogConverterPairs)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.admin.admin/GetConverterPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	SetFeeSchedule(context.Context, *FeeSchedule) (*emptypb.Empty, error)
+	DeleteFeeSchedule(context.Context, *FeeScheduleKey) (*emptypb.Empty, error)
+	GetFeeSchedules(context.Context, *emptypb.Empty) (*FeeSchedules, error)
+	// AddCurrency adds the currency or enables it when it already exists, a bank is added as
+	// classic currency with the bank code
+	AddCurrency(context.Context, *currencies.FullCurrency) (*emptypb.Empty, error)
+	SetCurrencyDisabled(context.Context, *CatalogCurrency) (*emptypb.Empty, error)
+	// DeleteCurrency deletes the currency with converter pairs and subscriptions of users using it
+	DeleteCurrency(context.Context, *currencies.FullCurrency) (*emptypb.Empty, error)
+	GetCurrencies(context.Context, *emptypb.Empty) (*CatalogCurrencies, error)
+	// AddConverterPair adds the converter pair with its missing currencies and enables it, the pair
+	// must be quotable
+	AddConverterPair(context.Context, *converter.ConverterPair) (*emptypb.Empty, error)
+	// SetConverterPairDisabled enables the converter pair only when it is quotable
+	SetConverterPairDisabled(context.Context, *CatalogConverterPair) (*emptypb.Empty, error)
+	DeleteConverterPair(context.Context, *converter.ConverterPair) (*emptypb.Empty, error)
+	GetConverterPairs(context.Context, *emptypb.Empty) (*CatalogConverterPairs, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) SetFeeSchedule(context.Context, *FeeSchedule) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSchedule not implemented")
+}
+func (UnimplementedAdminServer) DeleteFeeSchedule(context.Context, *FeeScheduleKey) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeeSchedule not implemented")
+}
+func (UnimplementedAdminServer) GetFeeSchedules(context.Context, *emptypb.Empty) (*FeeSchedules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeSchedules not implemented")
+}
+func (UnimplementedAdminServer) AddCurrency(context.Context, *currencies.FullCurrency) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCurrency not implemented")
+}
+func (UnimplementedAdminServer) SetCurrencyDisabled(context.Context, *CatalogCurrency) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCurrencyDisabled not implemented")
+}
+func (UnimplementedAdminServer) DeleteCurrency(context.Context, *currencies.FullCurrency) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCurrency not implemented")
+}
+func (UnimplementedAdminServer) GetCurrencies(context.Context, *emptypb.Empty) (*CatalogCurrencies, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencies not implemented")
+}
+func (UnimplementedAdminServer) AddConverterPair(context.Context, *converter.ConverterPair) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConverterPair not implemented")
+}
+func (UnimplementedAdminServer) SetConverterPairDisabled(context.Context, *CatalogConverterPair) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConverterPairDisabled not implemented")
+}
+func (UnimplementedAdminServer) DeleteConverterPair(context.Context, *converter.ConverterPair) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConverterPair not implemented")
+}
+func (UnimplementedAdminServer) GetConverterPairs(context.Context, *emptypb.Empty) (*CatalogConverterPairs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConverterPairs not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_SetFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/SetFeeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetFeeSchedule(ctx, req.(*FeeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeScheduleKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/DeleteFeeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteFeeSchedule(ctx, req.(*FeeScheduleKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetFeeSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetFeeSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/GetFeeSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetFeeSchedules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(currencies.FullCurrency)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/AddCurrency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddCurrency(ctx, req.(*currencies.FullCurrency))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetCurrencyDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogCurrency)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetCurrencyDisabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/SetCurrencyDisabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetCurrencyDisabled(ctx, req.(*CatalogCurrency))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(currencies.FullCurrency)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/DeleteCurrency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteCurrency(ctx, req.(*currencies.FullCurrency))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/GetCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetCurrencies(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddConverterPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(converter.ConverterPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddConverterPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/AddConverterPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddConverterPair(ctx, req.(*converter.ConverterPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetConverterPairDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogConverterPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetConverterPairDisabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/SetConverterPairDisabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetConverterPairDisabled(ctx, req.(*CatalogConverterPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteConverterPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(converter.ConverterPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteConverterPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/DeleteConverterPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteConverterPair(ctx, req.(*converter.ConverterPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetConverterPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetConverterPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.admin.admin/GetConverterPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetConverterPairs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "binance_converter.backend.admin.admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetFeeSchedule",
+			Handler:    _Admin_SetFeeSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteFeeSchedule",
+			Handler:    _Admin_DeleteFeeSchedule_Handler,
+		},
+		{
+			MethodName: "GetFeeSchedules",
+			Handler:    _Admin_GetFeeSchedules_Handler,
+		},
+		{
+			MethodName: "AddCurrency",
+			Handler:    _Admin_AddCurrency_Handler,
+		},
+		{
+			MethodName: "SetCurrencyDisabled",
+			Handler:    _Admin_SetCurrencyDisabled_Handler,
+		},
+		{
+			MethodName: "DeleteCurrency",
+			Handler:    _Admin_DeleteCurrency_Handler,
+		},
+		{
+			MethodName: "GetCurrencies",
+			Handler:    _Admin_GetCurrencies_Handler,
+		},
+		{
+			MethodName: "AddConverterPair",
+			Handler:    _Admin_AddConverterPair_Handler,
+		},
+		{
+			MethodName: "SetConverterPairDisabled",
+			Handler:    _Admin_SetConverterPairDisabled_Handler,
+		},
+		{
+			MethodName: "DeleteConverterPair",
+			Handler:    _Admin_DeleteConverterPair_Handler,
+		},
+		{
+			MethodName: "GetConverterPairs",
+			Handler:    _Admin_GetConverterPairs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/admin.proto",
+}
@@ -0,0 +1,457 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.9
+// source: proto/alerts.proto
+
+package alerts
+
+import (
+	converter "github.com/binance-converter/backend-api/api/converter"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EAlertDirection int32
+
+const (
+	EAlertDirection_UP   EAlertDirection = 0
+	EAlertDirection_DOWN EAlertDirection = 1
+)
+
+// Enum value maps for EAlertDirection.
+var (
+	EAlertDirection_name = map[int32]string{
+		0: "UP",
+		1: "DOWN",
+	}
+	EAlertDirection_value = map[string]int32{
+		"UP":   0,
+		"DOWN": 1,
+	}
+)
+
+func (x EAlertDirection) Enum() *EAlertDirection {
+	p := new(EAlertDirection)
+	*p = x
+	return p
+}
+
+func (x EAlertDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAlertDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_alerts_proto_enumTypes[0].Descriptor()
+}
+
+func (EAlertDirection) Type() protoreflect.EnumType {
+	return &file_proto_alerts_proto_enumTypes[0]
+}
+
+func (x EAlertDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EAlertDirection.Descriptor instead.
+func (EAlertDirection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_alerts_proto_rawDescGZIP(), []int{0}
+}
+
+type AdditionalErrorCode int32
+
+const (
+	AdditionalErrorCode_OK             AdditionalErrorCode = 0
+	AdditionalErrorCode_INVALID_OFFSET AdditionalErrorCode = 100
+)
+
+// Enum value maps for AdditionalErrorCode.
+var (
+	AdditionalErrorCode_name = map[int32]string{
+		0:   "OK",
+		100: "INVALID_OFFSET",
+	}
+	AdditionalErrorCode_value = map[string]int32{
+		"OK":             0,
+		"INVALID_OFFSET": 100,
+	}
+)
+
+func (x AdditionalErrorCode) Enum() *AdditionalErrorCode {
+	p := new(AdditionalErrorCode)
+	*p = x
+	return p
+}
+
+func (x AdditionalErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdditionalErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_alerts_proto_enumTypes[1].Descriptor()
+}
+
+func (AdditionalErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_alerts_proto_enumTypes[1]
+}
+
+func (x AdditionalErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdditionalErrorCode.Descriptor instead.
+func (AdditionalErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_alerts_proto_rawDescGZIP(), []int{1}
+}
+
+type SubscribeAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset of the last alert received by the client, alerts after it are sent first
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *SubscribeAlertsRequest) Reset() {
+	*x = SubscribeAlertsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_alerts_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAlertsRequest) ProtoMessage() {}
+
+func (x *SubscribeAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alerts_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAlertsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alerts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeAlertsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type Alert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset        uint64                   `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	ChatId        int64                    `protobuf:"varint,2,opt,name=chatId,proto3" json:"chatId,omitempty"`
+	ConverterPair *converter.ConverterPair `protobuf:"bytes,3,opt,name=converterPair,proto3" json:"converterPair,omitempty"`
+	OldExchange   *converter.Exchange      `protobuf:"bytes,4,opt,name=oldExchange,proto3" json:"oldExchange,omitempty"`
+	NewExchange   *converter.Exchange      `protobuf:"bytes,5,opt,name=newExchange,proto3" json:"newExchange,omitempty"`
+	Threshold     *converter.Exchange      `protobuf:"bytes,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Direction     EAlertDirection          `protobuf:"varint,7,opt,name=direction,proto3,enum=binance_converter.backend.alerts.EAlertDirection" json:"direction,omitempty"`
+	CreatedAt     *timestamppb.Timestamp   `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// exact decimal values of exchanges above
+	OldExchangeDecimal string `protobuf:"bytes,9,opt,name=oldExchangeDecimal,proto3" json:"oldExchangeDecimal,omitempty"`
+	NewExchangeDecimal string `protobuf:"bytes,10,opt,name=newExchangeDecimal,proto3" json:"newExchangeDecimal,omitempty"`
+	ThresholdDecimal   string `protobuf:"bytes,11,opt,name=thresholdDecimal,proto3" json:"thresholdDecimal,omitempty"`
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_alerts_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alerts_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_proto_alerts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Alert) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Alert) GetChatId() int64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *Alert) GetConverterPair() *converter.ConverterPair {
+	if x != nil {
+		return x.ConverterPair
+	}
+	return nil
+}
+
+func (x *Alert) GetOldExchange() *converter.Exchange {
+	if x != nil {
+		return x.OldExchange
+	}
+	return nil
+}
+
+func (x *Alert) GetNewExchange() *converter.Exchange {
+	if x != nil {
+		return x.NewExchange
+	}
+	return nil
+}
+
+func (x *Alert) GetThreshold() *converter.Exchange {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *Alert) GetDirection() EAlertDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return EAlertDirection_UP
+}
+
+func (x *Alert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetOldExchangeDecimal() string {
+	if x != nil {
+		return x.OldExchangeDecimal
+	}
+	return ""
+}
+
+func (x *Alert) GetNewExchangeDecimal() string {
+	if x != nil {
+		return x.NewExchangeDecimal
+	}
+	return ""
+}
+
+func (x *Alert) GetThresholdDecimal() string {
+	if x != nil {
+		return x.ThresholdDecimal
+	}
+	return ""
+}
+
+var File_proto_alerts_proto protoreflect.FileDescriptor
+
+var file_proto_alerts_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x30,
+	0x0a, 0x16, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0xa7, 0x05, 0x0a, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x5c, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x12, 0x53, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x53, 0x0a,
+	0x0b, 0x6e, 0x65, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x4f, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x4f, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x2e, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e,
+	0x0a, 0x12, 0x6f, 0x6c, 0x64, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x6c, 0x64, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x2e,
+	0x0a, 0x12, 0x6e, 0x65, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x2a,
+	0x0a, 0x10, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2a, 0x23, 0x0a, 0x0f, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a,
+	0x02, 0x55, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x2a,
+	0x31, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4f, 0x46, 0x46, 0x53, 0x45, 0x54,
+	0x10, 0x64, 0x32, 0x80, 0x01, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x76, 0x0a,
+	0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73,
+	0x12, 0x38, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x2e, 0x61, 0x6c,
+	0x65, 0x72, 0x74, 0x30, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_alerts_proto_rawDescOnce sync.Once
+	file_proto_alerts_proto_rawDescData = file_proto_alerts_proto_rawDesc
+)
+
+func file_proto_alerts_proto_rawDescGZIP() []byte {
+	file_proto_alerts_proto_rawDescOnce.Do(func() {
+		file_proto_alerts_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_alerts_proto_rawDescData)
+	})
+	return file_proto_alerts_proto_rawDescData
+}
+
+var file_proto_alerts_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_alerts_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_alerts_proto_goTypes = []interface{}{
+	(EAlertDirection)(0),            // 0: binance_converter.backend.alerts.eAlertDirection
+	(AdditionalErrorCode)(0),        // 1: binance_converter.backend.alerts.AdditionalErrorCode
+	(*SubscribeAlertsRequest)(nil),  // 2: binance_converter.backend.alerts.subscribeAlertsRequest
+	(*Alert)(nil),                   // 3: binance_converter.backend.alerts.alert
+	(*converter.ConverterPair)(nil), // 4: binance_converter.backend_api.converter.converterPair
+	(*converter.Exchange)(nil),      // 5: binance_converter.backend_api.converter.exchange
+	(*timestamppb.Timestamp)(nil),   // 6: google.protobuf.Timestamp
+}
+var file_proto_alerts_proto_depIdxs = []int32{
+	4, // 0: binance_converter.backend.alerts.alert.converterPair:type_name -> binance_converter.backend_api.converter.converterPair
+	5, // 1: binance_converter.backend.alerts.alert.oldExchange:type_name -> binance_converter.backend_api.converter.exchange
+	5, // 2: binance_converter.backend.alerts.alert.newExchange:type_name -> binance_converter.backend_api.converter.exchange
+	5, // 3: binance_converter.backend.alerts.alert.threshold:type_name -> binance_converter.backend_api.converter.exchange
+	0, // 4: binance_converter.backend.alerts.alert.direction:type_name -> binance_converter.backend.alerts.eAlertDirection
+	6, // 5: binance_converter.backend.alerts.alert.createdAt:type_name -> google.protobuf.Timestamp
+	2, // 6: binance_converter.backend.alerts.alerts.SubscribeAlerts:input_type -> binance_converter.backend.alerts.subscribeAlertsRequest
+	3, // 7: binance_converter.backend.alerts.alerts.SubscribeAlerts:output_type -> binance_converter.backend.alerts.alert
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_alerts_proto_init() }
+func file_proto_alerts_proto_init() {
+	if File_proto_alerts_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_alerts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeAlertsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_alerts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_alerts_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_alerts_proto_goTypes,
+		DependencyIndexes: file_proto_alerts_proto_depIdxs,
+		EnumInfos:         file_proto_alerts_proto_enumTypes,
+		MessageInfos:      file_proto_alerts_proto_msgTypes,
+	}.Build()
+	File_proto_alerts_proto = out.File
+	file_proto_alerts_proto_rawDesc = nil
+	file_proto_alerts_proto_goTypes = nil
+	file_proto_alerts_proto_depIdxs = nil
+}
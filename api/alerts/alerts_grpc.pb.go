@@ -0,0 +1,132 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.9
+// source: proto/alerts.proto
+
+package alerts
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AlertsClient is the client API for Alerts service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AlertsClient interface {
+	SubscribeAlerts(ctx context.Context, in *SubscribeAlertsRequest, opts ...grpc.CallOption) (Alerts_SubscribeAlertsClient, error)
+}
+
+type alertsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlertsClient(cc grpc.ClientConnInterface) AlertsClient {
+	return &alertsClient{cc}
+}
+
+func (c *alertsClient) SubscribeAlerts(ctx context.Context, in *SubscribeAlertsRequest, opts ...grpc.CallOption) (Alerts_SubscribeAlertsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Alerts_ServiceDesc.Streams[0], "/binance_converter.backend.alerts.alerts/SubscribeAlerts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &alertsSubscribeAlertsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Alerts_SubscribeAlertsClient interface {
+	Recv() (*Alert, error)
+	grpc.ClientStream
+}
+
+type alertsSubscribeAlertsClient struct {
+	grpc.ClientStream
+}
+
+func (x *alertsSubscribeAlertsClient) Recv() (*Alert, error) {
+	m := new(Alert)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AlertsServer is the server API for Alerts service.
+// All implementations must embed UnimplementedAlertsServer
+// for forward compatibility
+type AlertsServer interface {
+	SubscribeAlerts(*SubscribeAlertsRequest, Alerts_SubscribeAlertsServer) error
+	mustEmbedUnimplementedAlertsServer()
+}
+
+// UnimplementedAlertsServer must be embedded to have forward compatible implementations.
+type UnimplementedAlertsServer struct {
+}
+
+func (UnimplementedAlertsServer) SubscribeAlerts(*SubscribeAlertsRequest, Alerts_SubscribeAlertsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAlerts not implemented")
+}
+func (UnimplementedAlertsServer) mustEmbedUnimplementedAlertsServer() {}
+
+// UnsafeAlertsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlertsServer will
+// result in compilation errors.
+type UnsafeAlertsServer interface {
+	mustEmbedUnimplementedAlertsServer()
+}
+
+func RegisterAlertsServer(s grpc.ServiceRegistrar, srv AlertsServer) {
+	s.RegisterService(&Alerts_ServiceDesc, srv)
+}
+
+func _Alerts_SubscribeAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertsServer).SubscribeAlerts(m, &alertsSubscribeAlertsServer{stream})
+}
+
+type Alerts_SubscribeAlertsServer interface {
+	Send(*Alert) error
+	grpc.ServerStream
+}
+
+type alertsSubscribeAlertsServer struct {
+	grpc.ServerStream
+}
+
+func (x *alertsSubscribeAlertsServer) Send(m *Alert) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Alerts_ServiceDesc is the grpc.ServiceDesc for Alerts service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Alerts_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "binance_converter.backend.alerts.alerts",
+	HandlerType: (*AlertsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeAlerts",
+			Handler:       _Alerts_SubscribeAlerts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/alerts.proto",
+}
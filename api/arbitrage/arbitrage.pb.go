@@ -0,0 +1,580 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.9
+// source: proto/arbitrage.proto
+
+package arbitrage
+
+import (
+	converter "github.com/binance-converter/backend-api/api/converter"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdditionalErrorCode int32
+
+const (
+	AdditionalErrorCode_OK             AdditionalErrorCode = 0
+	AdditionalErrorCode_INVALID_OFFSET AdditionalErrorCode = 100
+	AdditionalErrorCode_INVALID_LIMIT  AdditionalErrorCode = 101
+)
+
+// Enum value maps for AdditionalErrorCode.
+var (
+	AdditionalErrorCode_name = map[int32]string{
+		0:   "OK",
+		100: "INVALID_OFFSET",
+		101: "INVALID_LIMIT",
+	}
+	AdditionalErrorCode_value = map[string]int32{
+		"OK":             0,
+		"INVALID_OFFSET": 100,
+		"INVALID_LIMIT":  101,
+	}
+)
+
+func (x AdditionalErrorCode) Enum() *AdditionalErrorCode {
+	p := new(AdditionalErrorCode)
+	*p = x
+	return p
+}
+
+func (x AdditionalErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdditionalErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_arbitrage_proto_enumTypes[0].Descriptor()
+}
+
+func (AdditionalErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_arbitrage_proto_enumTypes[0]
+}
+
+func (x AdditionalErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdditionalErrorCode.Descriptor instead.
+func (AdditionalErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_arbitrage_proto_rawDescGZIP(), []int{0}
+}
+
+type OpportunitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset of the last opportunity received by the client
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// maximum number of opportunities, default limit is used when it is zero
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *OpportunitiesRequest) Reset() {
+	*x = OpportunitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_arbitrage_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpportunitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpportunitiesRequest) ProtoMessage() {}
+
+func (x *OpportunitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_arbitrage_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpportunitiesRequest.ProtoReflect.Descriptor instead.
+func (*OpportunitiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_arbitrage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpportunitiesRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *OpportunitiesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Opportunity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// closed route, its first and last currencies are equal
+	Cycle *converter.ConverterPair `protobuf:"bytes,2,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	// amount of the first currency received for one of it after the cycle
+	Rate float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	// rate after fees
+	NetRate    float64                `protobuf:"fixed64,4,opt,name=netRate,proto3" json:"netRate,omitempty"`
+	DetectedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=detectedAt,proto3" json:"detectedAt,omitempty"`
+	// exact decimal values of rates above
+	RateDecimal    string `protobuf:"bytes,6,opt,name=rateDecimal,proto3" json:"rateDecimal,omitempty"`
+	NetRateDecimal string `protobuf:"bytes,7,opt,name=netRateDecimal,proto3" json:"netRateDecimal,omitempty"`
+}
+
+func (x *Opportunity) Reset() {
+	*x = Opportunity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_arbitrage_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Opportunity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Opportunity) ProtoMessage() {}
+
+func (x *Opportunity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_arbitrage_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Opportunity.ProtoReflect.Descriptor instead.
+func (*Opportunity) Descriptor() ([]byte, []int) {
+	return file_proto_arbitrage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Opportunity) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Opportunity) GetCycle() *converter.ConverterPair {
+	if x != nil {
+		return x.Cycle
+	}
+	return nil
+}
+
+func (x *Opportunity) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Opportunity) GetNetRate() float64 {
+	if x != nil {
+		return x.NetRate
+	}
+	return 0
+}
+
+func (x *Opportunity) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *Opportunity) GetRateDecimal() string {
+	if x != nil {
+		return x.RateDecimal
+	}
+	return ""
+}
+
+func (x *Opportunity) GetNetRateDecimal() string {
+	if x != nil {
+		return x.NetRateDecimal
+	}
+	return ""
+}
+
+type Opportunities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Opportunities []*Opportunity `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+}
+
+func (x *Opportunities) Reset() {
+	*x = Opportunities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_arbitrage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Opportunities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Opportunities) ProtoMessage() {}
+
+func (x *Opportunities) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_arbitrage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Opportunities.ProtoReflect.Descriptor instead.
+func (*Opportunities) Descriptor() ([]byte, []int) {
+	return file_proto_arbitrage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Opportunities) GetOpportunities() []*Opportunity {
+	if x != nil {
+		return x.Opportunities
+	}
+	return nil
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_arbitrage_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_arbitrage_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_proto_arbitrage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Subscription) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type Subscribers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatIds []int64 `protobuf:"varint,1,rep,packed,name=chatIds,proto3" json:"chatIds,omitempty"`
+}
+
+func (x *Subscribers) Reset() {
+	*x = Subscribers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_arbitrage_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscribers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscribers) ProtoMessage() {}
+
+func (x *Subscribers) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_arbitrage_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscribers.ProtoReflect.Descriptor instead.
+func (*Subscribers) Descriptor() ([]byte, []int) {
+	return file_proto_arbitrage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Subscribers) GetChatIds() []int64 {
+	if x != nil {
+		return x.ChatIds
+	}
+	return nil
+}
+
+var File_proto_arbitrage_proto protoreflect.FileDescriptor
+
+var file_proto_arbitrage_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x23, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x44, 0x0a, 0x14, 0x6f, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa7, 0x02, 0x0a, 0x0b, 0x6f, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x4c, 0x0a, 0x05, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x05, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x22, 0x67, 0x0a, 0x0d, 0x6f, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x56, 0x0a, 0x0d, 0x6f, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x6f, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x0d, 0x6f, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0x27, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x73, 0x2a, 0x44, 0x0a,
+	0x13, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4f, 0x46, 0x46, 0x53, 0x45, 0x54, 0x10, 0x64,
+	0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x10, 0x65, 0x32, 0xe4, 0x02, 0x0a, 0x09, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x8a, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61,
+	0x67, 0x65, 0x4f, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x39, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x72, 0x62, 0x69,
+	0x74, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x6f, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x6f, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x65,
+	0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x63, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x72, 0x62, 0x69,
+	0x74, 0x72, 0x61, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x30, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x2d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_arbitrage_proto_rawDescOnce sync.Once
+	file_proto_arbitrage_proto_rawDescData = file_proto_arbitrage_proto_rawDesc
+)
+
+func file_proto_arbitrage_proto_rawDescGZIP() []byte {
+	file_proto_arbitrage_proto_rawDescOnce.Do(func() {
+		file_proto_arbitrage_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_arbitrage_proto_rawDescData)
+	})
+	return file_proto_arbitrage_proto_rawDescData
+}
+
+var file_proto_arbitrage_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_arbitrage_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_arbitrage_proto_goTypes = []interface{}{
+	(AdditionalErrorCode)(0),        // 0: binance_converter.backend.arbitrage.AdditionalErrorCode
+	(*OpportunitiesRequest)(nil),    // 1: binance_converter.backend.arbitrage.opportunitiesRequest
+	(*Opportunity)(nil),             // 2: binance_converter.backend.arbitrage.opportunity
+	(*Opportunities)(nil),           // 3: binance_converter.backend.arbitrage.opportunities
+	(*Subscription)(nil),            // 4: binance_converter.backend.arbitrage.subscription
+	(*Subscribers)(nil),             // 5: binance_converter.backend.arbitrage.subscribers
+	(*converter.ConverterPair)(nil), // 6: binance_converter.backend_api.converter.converterPair
+	(*timestamppb.Timestamp)(nil),   // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 8: google.protobuf.Empty
+}
+var file_proto_arbitrage_proto_depIdxs = []int32{
+	6, // 0: binance_converter.backend.arbitrage.opportunity.cycle:type_name -> binance_converter.backend_api.converter.converterPair
+	7, // 1: binance_converter.backend.arbitrage.opportunity.detectedAt:type_name -> google.protobuf.Timestamp
+	2, // 2: binance_converter.backend.arbitrage.opportunities.opportunities:type_name -> binance_converter.backend.arbitrage.opportunity
+	1, // 3: binance_converter.backend.arbitrage.arbitrage.GetArbitrageOpportunities:input_type -> binance_converter.backend.arbitrage.opportunitiesRequest
+	4, // 4: binance_converter.backend.arbitrage.arbitrage.SetArbitrageSubscription:input_type -> binance_converter.backend.arbitrage.subscription
+	8, // 5: binance_converter.backend.arbitrage.arbitrage.GetArbitrageSubscribers:input_type -> google.protobuf.Empty
+	3, // 6: binance_converter.backend.arbitrage.arbitrage.GetArbitrageOpportunities:output_type -> binance_converter.backend.arbitrage.opportunities
+	8, // 7: binance_converter.backend.arbitrage.arbitrage.SetArbitrageSubscription:output_type -> google.protobuf.Empty
+	5, // 8: binance_converter.backend.arbitrage.arbitrage.GetArbitrageSubscribers:output_type -> binance_converter.backend.arbitrage.subscribers
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_arbitrage_proto_init() }
+func file_proto_arbitrage_proto_init() {
+	if File_proto_arbitrage_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_arbitrage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpportunitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_arbitrage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Opportunity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_arbitrage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Opportunities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_arbitrage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_arbitrage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscribers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_arbitrage_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_arbitrage_proto_goTypes,
+		DependencyIndexes: file_proto_arbitrage_proto_depIdxs,
+		EnumInfos:         file_proto_arbitrage_proto_enumTypes,
+		MessageInfos:      file_proto_arbitrage_proto_msgTypes,
+	}.Build()
+	File_proto_arbitrage_proto = out.File
+	file_proto_arbitrage_proto_rawDesc = nil
+	file_proto_arbitrage_proto_goTypes = nil
+	file_proto_arbitrage_proto_depIdxs = nil
+}
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.9
+// source: proto/arbitrage.proto
+
+package arbitrage
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ArbitrageClient is the client API for Arbitrage service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ArbitrageClient interface {
+	GetArbitrageOpportunities(ctx context.Context, in *OpportunitiesRequest, opts ...grpc.CallOption) (*Opportunities, error)
+	SetArbitrageSubscription(ctx context.Context, in *Subscription, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetArbitrageSubscribers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Subscribers, error)
+}
+
+type arbitrageClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewArbitrageClient(cc grpc.ClientConnInterface) ArbitrageClient {
+	return &arbitrageClient{cc}
+}
+
+func (c *arbitrageClient) GetArbitrageOpportunities(ctx context.Context, in *OpportunitiesRequest, opts ...grpc.CallOption) (*Opportunities, error) {
+	out := new(Opportunities)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.arbitrage.arbitrage/GetArbitrageOpportunities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arbitrageClient) SetArbitrageSubscription(ctx context.Context, in *Subscription, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.arbitrage.arbitrage/SetArbitrageSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arbitrageClient) GetArbitrageSubscribers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Subscribers, error) {
+	out := new(Subscribers)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.arbitrage.arbitrage/GetArbitrageSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArbitrageServer is the server API for Arbitrage service.
+// All implementations must embed UnimplementedArbitrageServer
+// for forward compatibility
+type ArbitrageServer interface {
+	GetArbitrageOpportunities(context.Context, *OpportunitiesRequest) (*Opportunities, error)
+	SetArbitrageSubscription(context.Context, *Subscription) (*emptypb.Empty, error)
+	GetArbitrageSubscribers(context.Context, *emptypb.Empty) (*Subscribers, error)
+	mustEmbedUnimplementedArbitrageServer()
+}
+
+// UnimplementedArbitrageServer must be embedded to have forward compatible implementations.
+type UnimplementedArbitrageServer struct {
+}
+
+func (UnimplementedArbitrageServer) GetArbitrageOpportunities(context.Context, *OpportunitiesRequest) (*Opportunities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunities not implemented")
+}
+func (UnimplementedArbitrageServer) SetArbitrageSubscription(context.Context, *Subscription) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetArbitrageSubscription not implemented")
+}
+func (UnimplementedArbitrageServer) GetArbitrageSubscribers(context.Context, *emptypb.Empty) (*Subscribers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArbitrageSubscribers not implemented")
+}
+func (UnimplementedArbitrageServer) mustEmbedUnimplementedArbitrageServer() {}
+
+// UnsafeArbitrageServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArbitrageServer will
+// result in compilation errors.
+type UnsafeArbitrageServer interface {
+	mustEmbedUnimplementedArbitrageServer()
+}
+
+func RegisterArbitrageServer(s grpc.ServiceRegistrar, srv ArbitrageServer) {
+	s.RegisterService(&Arbitrage_ServiceDesc, srv)
+}
+
+func _Arbitrage_GetArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpportunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArbitrageServer).GetArbitrageOpportunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.arbitrage.arbitrage/GetArbitrageOpportunities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArbitrageServer).GetArbitrageOpportunities(ctx, req.(*OpportunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Arbitrage_SetArbitrageSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Subscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArbitrageServer).SetArbitrageSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.arbitrage.arbitrage/SetArbitrageSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArbitrageServer).SetArbitrageSubscription(ctx, req.(*Subscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Arbitrage_GetArbitrageSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArbitrageServer).GetArbitrageSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.arbitrage.arbitrage/GetArbitrageSubscribers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArbitrageServer).GetArbitrageSubscribers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Arbitrage_ServiceDesc is the grpc.ServiceDesc for Arbitrage service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Arbitrage_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "binance_converter.backend.arbitrage.arbitrage",
+	HandlerType: (*ArbitrageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetArbitrageOpportunities",
+			Handler:    _Arbitrage_GetArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "SetArbitrageSubscription",
+			Handler:    _Arbitrage_SetArbitrageSubscription_Handler,
+		},
+		{
+			MethodName: "GetArbitrageSubscribers",
+			Handler:    _Arbitrage_GetArbitrageSubscribers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/arbitrage.proto",
+}
@@ -0,0 +1,2699 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.9
+// source: proto/converter_ext.proto
+
+package converter_ext
+
+import (
+	converter "github.com/binance-converter/backend-api/api/converter"
+	currencies "github.com/binance-converter/backend-api/api/currencies"
+	exchange_plot "github.com/binance-converter/backend-api/api/exchange_plot"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ECandleTimeframe int32
+
+const (
+	ECandleTimeframe_ONE_MINUTE   ECandleTimeframe = 0
+	ECandleTimeframe_FIVE_MINUTES ECandleTimeframe = 1
+	ECandleTimeframe_ONE_HOUR     ECandleTimeframe = 2
+	ECandleTimeframe_ONE_DAY      ECandleTimeframe = 3
+)
+
+// Enum value maps for ECandleTimeframe.
+var (
+	ECandleTimeframe_name = map[int32]string{
+		0: "ONE_MINUTE",
+		1: "FIVE_MINUTES",
+		2: "ONE_HOUR",
+		3: "ONE_DAY",
+	}
+	ECandleTimeframe_value = map[string]int32{
+		"ONE_MINUTE":   0,
+		"FIVE_MINUTES": 1,
+		"ONE_HOUR":     2,
+		"ONE_DAY":      3,
+	}
+)
+
+func (x ECandleTimeframe) Enum() *ECandleTimeframe {
+	p := new(ECandleTimeframe)
+	*p = x
+	return p
+}
+
+func (x ECandleTimeframe) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ECandleTimeframe) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_converter_ext_proto_enumTypes[0].Descriptor()
+}
+
+func (ECandleTimeframe) Type() protoreflect.EnumType {
+	return &file_proto_converter_ext_proto_enumTypes[0]
+}
+
+func (x ECandleTimeframe) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ECandleTimeframe.Descriptor instead.
+func (ECandleTimeframe) EnumDescriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{0}
+}
+
+type ETradeSide int32
+
+const (
+	ETradeSide_AUTO ETradeSide = 0
+	ETradeSide_BUY  ETradeSide = 1
+	ETradeSide_SELL ETradeSide = 2
+)
+
+// Enum value maps for ETradeSide.
+var (
+	ETradeSide_name = map[int32]string{
+		0: "AUTO",
+		1: "BUY",
+		2: "SELL",
+	}
+	ETradeSide_value = map[string]int32{
+		"AUTO": 0,
+		"BUY":  1,
+		"SELL": 2,
+	}
+)
+
+func (x ETradeSide) Enum() *ETradeSide {
+	p := new(ETradeSide)
+	*p = x
+	return p
+}
+
+func (x ETradeSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ETradeSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_converter_ext_proto_enumTypes[1].Descriptor()
+}
+
+func (ETradeSide) Type() protoreflect.EnumType {
+	return &file_proto_converter_ext_proto_enumTypes[1]
+}
+
+func (x ETradeSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ETradeSide.Descriptor instead.
+func (ETradeSide) EnumDescriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{1}
+}
+
+type EFeeScope int32
+
+const (
+	EFeeScope_BANK     EFeeScope = 0
+	EFeeScope_PROVIDER EFeeScope = 1
+)
+
+// Enum value maps for EFeeScope.
+var (
+	EFeeScope_name = map[int32]string{
+		0: "BANK",
+		1: "PROVIDER",
+	}
+	EFeeScope_value = map[string]int32{
+		"BANK":     0,
+		"PROVIDER": 1,
+	}
+)
+
+func (x EFeeScope) Enum() *EFeeScope {
+	p := new(EFeeScope)
+	*p = x
+	return p
+}
+
+func (x EFeeScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EFeeScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_converter_ext_proto_enumTypes[2].Descriptor()
+}
+
+func (EFeeScope) Type() protoreflect.EnumType {
+	return &file_proto_converter_ext_proto_enumTypes[2]
+}
+
+func (x EFeeScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EFeeScope.Descriptor instead.
+func (EFeeScope) EnumDescriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{2}
+}
+
+type AdditionalErrorCode int32
+
+const (
+	AdditionalErrorCode_OK                           AdditionalErrorCode = 0
+	AdditionalErrorCode_INVALID_CONVERTER_PAIR       AdditionalErrorCode = 100
+	AdditionalErrorCode_INVALID_TIME_INTERVAL        AdditionalErrorCode = 101
+	AdditionalErrorCode_INVALID_CANDLE_TIMEFRAME     AdditionalErrorCode = 102
+	AdditionalErrorCode_NOT_SUPPORTED_CONVERTER_PAIR AdditionalErrorCode = 103
+	AdditionalErrorCode_INVALID_AMOUNT               AdditionalErrorCode = 104
+	AdditionalErrorCode_INVALID_TRADE_SIDE           AdditionalErrorCode = 105
+	AdditionalErrorCode_INVALID_LIMIT                AdditionalErrorCode = 106
+	AdditionalErrorCode_PROVIDER_NOT_FOUND           AdditionalErrorCode = 107
+	AdditionalErrorCode_ROUTE_NOT_FOUND              AdditionalErrorCode = 108
+	AdditionalErrorCode_INVALID_MAX_LEGS             AdditionalErrorCode = 109
+	AdditionalErrorCode_AMOUNT_TOO_SMALL             AdditionalErrorCode = 110
+	AdditionalErrorCode_TARGET_NOT_REACHABLE         AdditionalErrorCode = 111
+	AdditionalErrorCode_INSUFFICIENT_DEPTH           AdditionalErrorCode = 112
+	AdditionalErrorCode_INVALID_ADVERTISER_FILTER    AdditionalErrorCode = 113
+)
+
+// Enum value maps for AdditionalErrorCode.
+var (
+	AdditionalErrorCode_name = map[int32]string{
+		0:   "OK",
+		100: "INVALID_CONVERTER_PAIR",
+		101: "INVALID_TIME_INTERVAL",
+		102: "INVALID_CANDLE_TIMEFRAME",
+		103: "NOT_SUPPORTED_CONVERTER_PAIR",
+		104: "INVALID_AMOUNT",
+		105: "INVALID_TRADE_SIDE",
+		106: "INVALID_LIMIT",
+		107: "PROVIDER_NOT_FOUND",
+		108: "ROUTE_NOT_FOUND",
+		109: "INVALID_MAX_LEGS",
+		110: "AMOUNT_TOO_SMALL",
+		111: "TARGET_NOT_REACHABLE",
+		112: "INSUFFICIENT_DEPTH",
+		113: "INVALID_ADVERTISER_FILTER",
+	}
+	AdditionalErrorCode_value = map[string]int32{
+		"OK":                           0,
+		"INVALID_CONVERTER_PAIR":       100,
+		"INVALID_TIME_INTERVAL":        101,
+		"INVALID_CANDLE_TIMEFRAME":     102,
+		"NOT_SUPPORTED_CONVERTER_PAIR": 103,
+		"INVALID_AMOUNT":               104,
+		"INVALID_TRADE_SIDE":           105,
+		"INVALID_LIMIT":                106,
+		"PROVIDER_NOT_FOUND":           107,
+		"ROUTE_NOT_FOUND":              108,
+		"INVALID_MAX_LEGS":             109,
+		"AMOUNT_TOO_SMALL":             110,
+		"TARGET_NOT_REACHABLE":         111,
+		"INSUFFICIENT_DEPTH":           112,
+		"INVALID_ADVERTISER_FILTER":    113,
+	}
+)
+
+func (x AdditionalErrorCode) Enum() *AdditionalErrorCode {
+	p := new(AdditionalErrorCode)
+	*p = x
+	return p
+}
+
+func (x AdditionalErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdditionalErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_converter_ext_proto_enumTypes[3].Descriptor()
+}
+
+func (AdditionalErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_converter_ext_proto_enumTypes[3]
+}
+
+func (x AdditionalErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdditionalErrorCode.Descriptor instead.
+func (AdditionalErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{3}
+}
+
+type ExchangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair *converter.ConverterPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// amount of the first currency of the pair, default amount is used when it is zero
+	Amount float64    `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Side   ETradeSide `protobuf:"varint,3,opt,name=side,proto3,enum=binance_converter.backend.converter_ext.ETradeSide" json:"side,omitempty"`
+	// providers to quote from, all providers are used when it is empty
+	Providers []string `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty"`
+	// exact decimal value of amount, it is used instead of float one when it is set
+	AmountDecimal string `protobuf:"bytes,5,opt,name=amountDecimal,proto3" json:"amountDecimal,omitempty"`
+}
+
+func (x *ExchangeRequest) Reset() {
+	*x = ExchangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRequest) ProtoMessage() {}
+
+func (x *ExchangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExchangeRequest) GetPair() *converter.ConverterPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ExchangeRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExchangeRequest) GetSide() ETradeSide {
+	if x != nil {
+		return x.Side
+	}
+	return ETradeSide_AUTO
+}
+
+func (x *ExchangeRequest) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *ExchangeRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+// decimalExchange is the exchange with its exact decimal value
+type DecimalExchange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// float value is kept for clients of converter exchange
+	Exchange        float32 `protobuf:"fixed32,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	ExchangeDecimal string  `protobuf:"bytes,2,opt,name=exchangeDecimal,proto3" json:"exchangeDecimal,omitempty"`
+}
+
+func (x *DecimalExchange) Reset() {
+	*x = DecimalExchange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecimalExchange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecimalExchange) ProtoMessage() {}
+
+func (x *DecimalExchange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecimalExchange.ProtoReflect.Descriptor instead.
+func (*DecimalExchange) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DecimalExchange) GetExchange() float32 {
+	if x != nil {
+		return x.Exchange
+	}
+	return 0
+}
+
+func (x *DecimalExchange) GetExchangeDecimal() string {
+	if x != nil {
+		return x.ExchangeDecimal
+	}
+	return ""
+}
+
+type RequiredAmountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair *converter.ConverterPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// amount of the last currency of the pair which should be received
+	TargetAmount float64 `protobuf:"fixed64,2,opt,name=targetAmount,proto3" json:"targetAmount,omitempty"`
+	// exact decimal value of target amount, it is used instead of float one when it is set
+	TargetAmountDecimal string     `protobuf:"bytes,3,opt,name=targetAmountDecimal,proto3" json:"targetAmountDecimal,omitempty"`
+	Side                ETradeSide `protobuf:"varint,4,opt,name=side,proto3,enum=binance_converter.backend.converter_ext.ETradeSide" json:"side,omitempty"`
+	// providers to quote from, all providers are used when it is empty
+	Providers []string `protobuf:"bytes,5,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *RequiredAmountRequest) Reset() {
+	*x = RequiredAmountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequiredAmountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequiredAmountRequest) ProtoMessage() {}
+
+func (x *RequiredAmountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequiredAmountRequest.ProtoReflect.Descriptor instead.
+func (*RequiredAmountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequiredAmountRequest) GetPair() *converter.ConverterPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *RequiredAmountRequest) GetTargetAmount() float64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *RequiredAmountRequest) GetTargetAmountDecimal() string {
+	if x != nil {
+		return x.TargetAmountDecimal
+	}
+	return ""
+}
+
+func (x *RequiredAmountRequest) GetSide() ETradeSide {
+	if x != nil {
+		return x.Side
+	}
+	return ETradeSide_AUTO
+}
+
+func (x *RequiredAmountRequest) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type RequiredAmount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amount of the first currency of the pair which should be sent
+	Amount        float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal string  `protobuf:"bytes,2,opt,name=amountDecimal,proto3" json:"amountDecimal,omitempty"`
+	// route quoted for the amount, it receives at least target amount
+	Route *Route `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+}
+
+func (x *RequiredAmount) Reset() {
+	*x = RequiredAmount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequiredAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequiredAmount) ProtoMessage() {}
+
+func (x *RequiredAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequiredAmount.ProtoReflect.Descriptor instead.
+func (*RequiredAmount) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RequiredAmount) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RequiredAmount) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *RequiredAmount) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+type DepthFill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offer *Offer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	// amount of the first currency sent to the offer
+	AmountIn float64 `protobuf:"fixed64,2,opt,name=amountIn,proto3" json:"amountIn,omitempty"`
+	// amount of the second currency received from the offer
+	AmountOut        float64 `protobuf:"fixed64,3,opt,name=amountOut,proto3" json:"amountOut,omitempty"`
+	AmountInDecimal  string  `protobuf:"bytes,4,opt,name=amountInDecimal,proto3" json:"amountInDecimal,omitempty"`
+	AmountOutDecimal string  `protobuf:"bytes,5,opt,name=amountOutDecimal,proto3" json:"amountOutDecimal,omitempty"`
+}
+
+func (x *DepthFill) Reset() {
+	*x = DepthFill{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepthFill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepthFill) ProtoMessage() {}
+
+func (x *DepthFill) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepthFill.ProtoReflect.Descriptor instead.
+func (*DepthFill) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DepthFill) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *DepthFill) GetAmountIn() float64 {
+	if x != nil {
+		return x.AmountIn
+	}
+	return 0
+}
+
+func (x *DepthFill) GetAmountOut() float64 {
+	if x != nil {
+		return x.AmountOut
+	}
+	return 0
+}
+
+func (x *DepthFill) GetAmountInDecimal() string {
+	if x != nil {
+		return x.AmountInDecimal
+	}
+	return ""
+}
+
+func (x *DepthFill) GetAmountOutDecimal() string {
+	if x != nil {
+		return x.AmountOutDecimal
+	}
+	return ""
+}
+
+type DepthQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair *converter.ConverterPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// volume weighted exchange of consumed offers
+	Exchange *converter.Exchange `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// exchange of the best offer
+	BestExchange *converter.Exchange `protobuf:"bytes,3,opt,name=bestExchange,proto3" json:"bestExchange,omitempty"`
+	// how much exchange is worse than the best one for the user
+	SlippagePercent float64      `protobuf:"fixed64,4,opt,name=slippagePercent,proto3" json:"slippagePercent,omitempty"`
+	AmountIn        float64      `protobuf:"fixed64,5,opt,name=amountIn,proto3" json:"amountIn,omitempty"`
+	AmountOut       float64      `protobuf:"fixed64,6,opt,name=amountOut,proto3" json:"amountOut,omitempty"`
+	Fills           []*DepthFill `protobuf:"bytes,7,rep,name=fills,proto3" json:"fills,omitempty"`
+	// exact decimal values of fields above
+	ExchangeDecimal        string `protobuf:"bytes,8,opt,name=exchangeDecimal,proto3" json:"exchangeDecimal,omitempty"`
+	BestExchangeDecimal    string `protobuf:"bytes,9,opt,name=bestExchangeDecimal,proto3" json:"bestExchangeDecimal,omitempty"`
+	SlippagePercentDecimal string `protobuf:"bytes,10,opt,name=slippagePercentDecimal,proto3" json:"slippagePercentDecimal,omitempty"`
+	AmountInDecimal        string `protobuf:"bytes,11,opt,name=amountInDecimal,proto3" json:"amountInDecimal,omitempty"`
+	AmountOutDecimal       string `protobuf:"bytes,12,opt,name=amountOutDecimal,proto3" json:"amountOutDecimal,omitempty"`
+}
+
+func (x *DepthQuote) Reset() {
+	*x = DepthQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepthQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepthQuote) ProtoMessage() {}
+
+func (x *DepthQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepthQuote.ProtoReflect.Descriptor instead.
+func (*DepthQuote) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DepthQuote) GetPair() *converter.ConverterPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *DepthQuote) GetExchange() *converter.Exchange {
+	if x != nil {
+		return x.Exchange
+	}
+	return nil
+}
+
+func (x *DepthQuote) GetBestExchange() *converter.Exchange {
+	if x != nil {
+		return x.BestExchange
+	}
+	return nil
+}
+
+func (x *DepthQuote) GetSlippagePercent() float64 {
+	if x != nil {
+		return x.SlippagePercent
+	}
+	return 0
+}
+
+func (x *DepthQuote) GetAmountIn() float64 {
+	if x != nil {
+		return x.AmountIn
+	}
+	return 0
+}
+
+func (x *DepthQuote) GetAmountOut() float64 {
+	if x != nil {
+		return x.AmountOut
+	}
+	return 0
+}
+
+func (x *DepthQuote) GetFills() []*DepthFill {
+	if x != nil {
+		return x.Fills
+	}
+	return nil
+}
+
+func (x *DepthQuote) GetExchangeDecimal() string {
+	if x != nil {
+		return x.ExchangeDecimal
+	}
+	return ""
+}
+
+func (x *DepthQuote) GetBestExchangeDecimal() string {
+	if x != nil {
+		return x.BestExchangeDecimal
+	}
+	return ""
+}
+
+func (x *DepthQuote) GetSlippagePercentDecimal() string {
+	if x != nil {
+		return x.SlippagePercentDecimal
+	}
+	return ""
+}
+
+func (x *DepthQuote) GetAmountInDecimal() string {
+	if x != nil {
+		return x.AmountInDecimal
+	}
+	return ""
+}
+
+func (x *DepthQuote) GetAmountOutDecimal() string {
+	if x != nil {
+		return x.AmountOutDecimal
+	}
+	return ""
+}
+
+type OffersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair *converter.ConverterPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// amount of the first currency of the pair, default amount is used when it is zero
+	Amount float64    `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Side   ETradeSide `protobuf:"varint,3,opt,name=side,proto3,enum=binance_converter.backend.converter_ext.ETradeSide" json:"side,omitempty"`
+	// maximum number of offers, default limit is used when it is zero
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// providers to quote from, all providers are used when it is empty
+	Providers []string `protobuf:"bytes,5,rep,name=providers,proto3" json:"providers,omitempty"`
+	// exact decimal value of amount, it is used instead of float one when it is set
+	AmountDecimal string `protobuf:"bytes,6,opt,name=amountDecimal,proto3" json:"amountDecimal,omitempty"`
+}
+
+func (x *OffersRequest) Reset() {
+	*x = OffersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffersRequest) ProtoMessage() {}
+
+func (x *OffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffersRequest.ProtoReflect.Descriptor instead.
+func (*OffersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OffersRequest) GetPair() *converter.ConverterPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *OffersRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OffersRequest) GetSide() ETradeSide {
+	if x != nil {
+		return x.Side
+	}
+	return ETradeSide_AUTO
+}
+
+func (x *OffersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *OffersRequest) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *OffersRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+type Advertiser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserNo          string  `protobuf:"bytes,1,opt,name=userNo,proto3" json:"userNo,omitempty"`
+	NickName        string  `protobuf:"bytes,2,opt,name=nickName,proto3" json:"nickName,omitempty"`
+	Merchant        bool    `protobuf:"varint,3,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	MonthOrderCount uint32  `protobuf:"varint,4,opt,name=monthOrderCount,proto3" json:"monthOrderCount,omitempty"`
+	CompletionRate  float64 `protobuf:"fixed64,5,opt,name=completionRate,proto3" json:"completionRate,omitempty"`
+}
+
+func (x *Advertiser) Reset() {
+	*x = Advertiser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Advertiser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advertiser) ProtoMessage() {}
+
+func (x *Advertiser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advertiser.ProtoReflect.Descriptor instead.
+func (*Advertiser) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Advertiser) GetUserNo() string {
+	if x != nil {
+		return x.UserNo
+	}
+	return ""
+}
+
+func (x *Advertiser) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+func (x *Advertiser) GetMerchant() bool {
+	if x != nil {
+		return x.Merchant
+	}
+	return false
+}
+
+func (x *Advertiser) GetMonthOrderCount() uint32 {
+	if x != nil {
+		return x.MonthOrderCount
+	}
+	return 0
+}
+
+func (x *Advertiser) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+// advertiserFilter selects offers quotes of the user are taken from, empty filter selects all
+// offers
+type AdvertiserFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MerchantOnly bool `protobuf:"varint,1,opt,name=merchantOnly,proto3" json:"merchantOnly,omitempty"`
+	// minimal share of completed orders for the last month in [0, 1]
+	MinCompletionRate float64 `protobuf:"fixed64,2,opt,name=minCompletionRate,proto3" json:"minCompletionRate,omitempty"`
+	MinOrderCount     uint32  `protobuf:"varint,3,opt,name=minOrderCount,proto3" json:"minOrderCount,omitempty"`
+	// user numbers of advertisers whose offers are skipped
+	ExcludedAdvertisers []string `protobuf:"bytes,4,rep,name=excludedAdvertisers,proto3" json:"excludedAdvertisers,omitempty"`
+}
+
+func (x *AdvertiserFilter) Reset() {
+	*x = AdvertiserFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdvertiserFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvertiserFilter) ProtoMessage() {}
+
+func (x *AdvertiserFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvertiserFilter.ProtoReflect.Descriptor instead.
+func (*AdvertiserFilter) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AdvertiserFilter) GetMerchantOnly() bool {
+	if x != nil {
+		return x.MerchantOnly
+	}
+	return false
+}
+
+func (x *AdvertiserFilter) GetMinCompletionRate() float64 {
+	if x != nil {
+		return x.MinCompletionRate
+	}
+	return 0
+}
+
+func (x *AdvertiserFilter) GetMinOrderCount() uint32 {
+	if x != nil {
+		return x.MinOrderCount
+	}
+	return 0
+}
+
+func (x *AdvertiserFilter) GetExcludedAdvertisers() []string {
+	if x != nil {
+		return x.ExcludedAdvertisers
+	}
+	return nil
+}
+
+type Offer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdvNo             string              `protobuf:"bytes,1,opt,name=advNo,proto3" json:"advNo,omitempty"`
+	Advertiser        *Advertiser         `protobuf:"bytes,2,opt,name=advertiser,proto3" json:"advertiser,omitempty"`
+	Exchange          *converter.Exchange `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	MinAmount         float64             `protobuf:"fixed64,4,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	MaxAmount         float64             `protobuf:"fixed64,5,opt,name=maxAmount,proto3" json:"maxAmount,omitempty"`
+	AvailableQuantity float64             `protobuf:"fixed64,6,opt,name=availableQuantity,proto3" json:"availableQuantity,omitempty"`
+	PayMethods        []string            `protobuf:"bytes,7,rep,name=payMethods,proto3" json:"payMethods,omitempty"`
+	Provider          string              `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
+	// exact decimal value of exchange
+	ExchangeDecimal string `protobuf:"bytes,9,opt,name=exchangeDecimal,proto3" json:"exchangeDecimal,omitempty"`
+	// exact decimal values of minAmount, maxAmount and availableQuantity
+	MinAmountDecimal         string `protobuf:"bytes,10,opt,name=minAmountDecimal,proto3" json:"minAmountDecimal,omitempty"`
+	MaxAmountDecimal         string `protobuf:"bytes,11,opt,name=maxAmountDecimal,proto3" json:"maxAmountDecimal,omitempty"`
+	AvailableQuantityDecimal string `protobuf:"bytes,12,opt,name=availableQuantityDecimal,proto3" json:"availableQuantityDecimal,omitempty"`
+}
+
+func (x *Offer) Reset() {
+	*x = Offer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Offer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offer) ProtoMessage() {}
+
+func (x *Offer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offer.ProtoReflect.Descriptor instead.
+func (*Offer) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Offer) GetAdvNo() string {
+	if x != nil {
+		return x.AdvNo
+	}
+	return ""
+}
+
+func (x *Offer) GetAdvertiser() *Advertiser {
+	if x != nil {
+		return x.Advertiser
+	}
+	return nil
+}
+
+func (x *Offer) GetExchange() *converter.Exchange {
+	if x != nil {
+		return x.Exchange
+	}
+	return nil
+}
+
+func (x *Offer) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *Offer) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *Offer) GetAvailableQuantity() float64 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *Offer) GetPayMethods() []string {
+	if x != nil {
+		return x.PayMethods
+	}
+	return nil
+}
+
+func (x *Offer) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Offer) GetExchangeDecimal() string {
+	if x != nil {
+		return x.ExchangeDecimal
+	}
+	return ""
+}
+
+func (x *Offer) GetMinAmountDecimal() string {
+	if x != nil {
+		return x.MinAmountDecimal
+	}
+	return ""
+}
+
+func (x *Offer) GetMaxAmountDecimal() string {
+	if x != nil {
+		return x.MaxAmountDecimal
+	}
+	return ""
+}
+
+func (x *Offer) GetAvailableQuantityDecimal() string {
+	if x != nil {
+		return x.AvailableQuantityDecimal
+	}
+	return ""
+}
+
+type Offers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offers []*Offer `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+}
+
+func (x *Offers) Reset() {
+	*x = Offers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Offers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offers) ProtoMessage() {}
+
+func (x *Offers) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offers.ProtoReflect.Descriptor instead.
+func (*Offers) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Offers) GetOffers() []*Offer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type RoutesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source *currencies.FullCurrency `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target *currencies.FullCurrency `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// amount of the source currency, default amount is used when it is zero
+	Amount float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// maximum number of legs of a route, default is used when it is zero
+	MaxLegs uint32 `protobuf:"varint,4,opt,name=maxLegs,proto3" json:"maxLegs,omitempty"`
+	// maximum number of routes, default limit is used when it is zero
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// providers to quote from, all providers are used when it is empty
+	Providers []string `protobuf:"bytes,6,rep,name=providers,proto3" json:"providers,omitempty"`
+	// exact decimal value of amount, it is used instead of float one when it is set
+	AmountDecimal string `protobuf:"bytes,7,opt,name=amountDecimal,proto3" json:"amountDecimal,omitempty"`
+}
+
+func (x *RoutesRequest) Reset() {
+	*x = RoutesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutesRequest) ProtoMessage() {}
+
+func (x *RoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutesRequest.ProtoReflect.Descriptor instead.
+func (*RoutesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RoutesRequest) GetSource() *currencies.FullCurrency {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *RoutesRequest) GetTarget() *currencies.FullCurrency {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *RoutesRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RoutesRequest) GetMaxLegs() uint32 {
+	if x != nil {
+		return x.MaxLegs
+	}
+	return 0
+}
+
+func (x *RoutesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RoutesRequest) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *RoutesRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+type Fee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope EFeeScope `protobuf:"varint,1,opt,name=scope,proto3,enum=binance_converter.backend.converter_ext.EFeeScope" json:"scope,omitempty"`
+	// bank code for bank fees and provider name for provider fees
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// amount of the fee in the second currency of the leg
+	Amount        float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal string  `protobuf:"bytes,4,opt,name=amountDecimal,proto3" json:"amountDecimal,omitempty"`
+}
+
+func (x *Fee) Reset() {
+	*x = Fee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fee) ProtoMessage() {}
+
+func (x *Fee) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fee.ProtoReflect.Descriptor instead.
+func (*Fee) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Fee) GetScope() EFeeScope {
+	if x != nil {
+		return x.Scope
+	}
+	return EFeeScope_BANK
+}
+
+func (x *Fee) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Fee) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Fee) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+type RouteLeg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair     *converter.ConverterPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Exchange *converter.Exchange      `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// amount of the second currency received for one of the first one after fees
+	Rate     float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	AmountIn float64 `protobuf:"fixed64,4,opt,name=amountIn,proto3" json:"amountIn,omitempty"`
+	// amount received after fees
+	AmountOut float64 `protobuf:"fixed64,5,opt,name=amountOut,proto3" json:"amountOut,omitempty"`
+	// provider which quoted the leg
+	Provider string `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	Fees     []*Fee `protobuf:"bytes,7,rep,name=fees,proto3" json:"fees,omitempty"`
+	// exact decimal values of fields above
+	ExchangeDecimal  string `protobuf:"bytes,8,opt,name=exchangeDecimal,proto3" json:"exchangeDecimal,omitempty"`
+	RateDecimal      string `protobuf:"bytes,9,opt,name=rateDecimal,proto3" json:"rateDecimal,omitempty"`
+	AmountInDecimal  string `protobuf:"bytes,10,opt,name=amountInDecimal,proto3" json:"amountInDecimal,omitempty"`
+	AmountOutDecimal string `protobuf:"bytes,11,opt,name=amountOutDecimal,proto3" json:"amountOutDecimal,omitempty"`
+}
+
+func (x *RouteLeg) Reset() {
+	*x = RouteLeg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteLeg) ProtoMessage() {}
+
+func (x *RouteLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteLeg.ProtoReflect.Descriptor instead.
+func (*RouteLeg) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RouteLeg) GetPair() *converter.ConverterPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *RouteLeg) GetExchange() *converter.Exchange {
+	if x != nil {
+		return x.Exchange
+	}
+	return nil
+}
+
+func (x *RouteLeg) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RouteLeg) GetAmountIn() float64 {
+	if x != nil {
+		return x.AmountIn
+	}
+	return 0
+}
+
+func (x *RouteLeg) GetAmountOut() float64 {
+	if x != nil {
+		return x.AmountOut
+	}
+	return 0
+}
+
+func (x *RouteLeg) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RouteLeg) GetFees() []*Fee {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *RouteLeg) GetExchangeDecimal() string {
+	if x != nil {
+		return x.ExchangeDecimal
+	}
+	return ""
+}
+
+func (x *RouteLeg) GetRateDecimal() string {
+	if x != nil {
+		return x.RateDecimal
+	}
+	return ""
+}
+
+func (x *RouteLeg) GetAmountInDecimal() string {
+	if x != nil {
+		return x.AmountInDecimal
+	}
+	return ""
+}
+
+func (x *RouteLeg) GetAmountOutDecimal() string {
+	if x != nil {
+		return x.AmountOutDecimal
+	}
+	return ""
+}
+
+type Route struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair     *converter.ConverterPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Exchange *converter.Exchange      `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// effective amount of the target currency received for one of the source one
+	Rate float64     `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Legs []*RouteLeg `protobuf:"bytes,4,rep,name=legs,proto3" json:"legs,omitempty"`
+	// exact decimal values of fields above
+	ExchangeDecimal string `protobuf:"bytes,5,opt,name=exchangeDecimal,proto3" json:"exchangeDecimal,omitempty"`
+	RateDecimal     string `protobuf:"bytes,6,opt,name=rateDecimal,proto3" json:"rateDecimal,omitempty"`
+	// spot is set when amount is not set, fixed fees are not charged on spot routes
+	Spot bool `protobuf:"varint,7,opt,name=spot,proto3" json:"spot,omitempty"`
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Route) GetPair() *converter.ConverterPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *Route) GetExchange() *converter.Exchange {
+	if x != nil {
+		return x.Exchange
+	}
+	return nil
+}
+
+func (x *Route) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Route) GetLegs() []*RouteLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *Route) GetExchangeDecimal() string {
+	if x != nil {
+		return x.ExchangeDecimal
+	}
+	return ""
+}
+
+func (x *Route) GetRateDecimal() string {
+	if x != nil {
+		return x.RateDecimal
+	}
+	return ""
+}
+
+func (x *Route) GetSpot() bool {
+	if x != nil {
+		return x.Spot
+	}
+	return false
+}
+
+type Routes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *Routes) Reset() {
+	*x = Routes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Routes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Routes) ProtoMessage() {}
+
+func (x *Routes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Routes.ProtoReflect.Descriptor instead.
+func (*Routes) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Routes) GetRoutes() []*Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type CandlesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair      *converter.ConverterPair    `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Timeframe ECandleTimeframe            `protobuf:"varint,2,opt,name=timeframe,proto3,enum=binance_converter.backend.converter_ext.ECandleTimeframe" json:"timeframe,omitempty"`
+	Interval  *exchange_plot.TimeInterval `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *CandlesRequest) Reset() {
+	*x = CandlesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandlesRequest) ProtoMessage() {}
+
+func (x *CandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandlesRequest.ProtoReflect.Descriptor instead.
+func (*CandlesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CandlesRequest) GetPair() *converter.ConverterPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *CandlesRequest) GetTimeframe() ECandleTimeframe {
+	if x != nil {
+		return x.Timeframe
+	}
+	return ECandleTimeframe_ONE_MINUTE
+}
+
+func (x *CandlesRequest) GetInterval() *exchange_plot.TimeInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type Candle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=openTime,proto3" json:"openTime,omitempty"`
+	Open        *converter.Exchange    `protobuf:"bytes,2,opt,name=open,proto3" json:"open,omitempty"`
+	High        *converter.Exchange    `protobuf:"bytes,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low         *converter.Exchange    `protobuf:"bytes,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close       *converter.Exchange    `protobuf:"bytes,5,opt,name=close,proto3" json:"close,omitempty"`
+	SampleCount uint32                 `protobuf:"varint,6,opt,name=sampleCount,proto3" json:"sampleCount,omitempty"`
+	// exact decimal values of fields above
+	OpenDecimal  string `protobuf:"bytes,7,opt,name=openDecimal,proto3" json:"openDecimal,omitempty"`
+	HighDecimal  string `protobuf:"bytes,8,opt,name=highDecimal,proto3" json:"highDecimal,omitempty"`
+	LowDecimal   string `protobuf:"bytes,9,opt,name=lowDecimal,proto3" json:"lowDecimal,omitempty"`
+	CloseDecimal string `protobuf:"bytes,10,opt,name=closeDecimal,proto3" json:"closeDecimal,omitempty"`
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Candle) GetOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenTime
+	}
+	return nil
+}
+
+func (x *Candle) GetOpen() *converter.Exchange {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *Candle) GetHigh() *converter.Exchange {
+	if x != nil {
+		return x.High
+	}
+	return nil
+}
+
+func (x *Candle) GetLow() *converter.Exchange {
+	if x != nil {
+		return x.Low
+	}
+	return nil
+}
+
+func (x *Candle) GetClose() *converter.Exchange {
+	if x != nil {
+		return x.Close
+	}
+	return nil
+}
+
+func (x *Candle) GetSampleCount() uint32 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+func (x *Candle) GetOpenDecimal() string {
+	if x != nil {
+		return x.OpenDecimal
+	}
+	return ""
+}
+
+func (x *Candle) GetHighDecimal() string {
+	if x != nil {
+		return x.HighDecimal
+	}
+	return ""
+}
+
+func (x *Candle) GetLowDecimal() string {
+	if x != nil {
+		return x.LowDecimal
+	}
+	return ""
+}
+
+func (x *Candle) GetCloseDecimal() string {
+	if x != nil {
+		return x.CloseDecimal
+	}
+	return ""
+}
+
+type Candles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candles []*Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+}
+
+func (x *Candles) Reset() {
+	*x = Candles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_converter_ext_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Candles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candles) ProtoMessage() {}
+
+func (x *Candles) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_converter_ext_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candles.ProtoReflect.Descriptor instead.
+func (*Candles) Descriptor() ([]byte, []int) {
+	return file_proto_converter_ext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Candles) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+var File_proto_converter_ext_proto protoreflect.FileDescriptor
+
+var file_proto_converter_ext_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x27, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x74, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x70, 0x6c, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x02, 0x0a,
+	0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4a, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x33, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x65, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x22, 0x57, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x22, 0xa0, 0x02, 0x0a, 0x15, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x65,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x94, 0x01,
+	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x44,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x74, 0x68, 0x46, 0x69,
+	0x6c, 0x6c, 0x12, 0x44, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x75, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x10,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75,
+	0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x22, 0x96, 0x05, 0x0a, 0x0a, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70,
+	0x61, 0x69, 0x72, 0x12, 0x4d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x62, 0x65, 0x73,
+	0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6c, 0x69,
+	0x70, 0x70, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x48, 0x0a,
+	0x05, 0x66, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x62,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x65, 0x70, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x6c,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x12, 0x30, 0x0a, 0x13, 0x62, 0x65, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x62, 0x65, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x16, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x75, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x22, 0x96, 0x02, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e,
+	0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x22, 0xae, 0x01, 0x0a, 0x0a, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x10,
+	0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41,
+	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x72, 0x73, 0x22, 0xa5, 0x04, 0x0a, 0x05, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x76, 0x4e, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x76, 0x4e, 0x6f, 0x12, 0x53, 0x0a, 0x0a, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x73, 0x65, 0x72, 0x52, 0x0a, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x72, 0x12,
+	0x4d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61,
+	0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x2a,
+	0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x61,
+	0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x18, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x22, 0x50, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x46, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x22, 0xbb, 0x02, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x2e, 0x66, 0x75, 0x6c, 0x6c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x2e, 0x66, 0x75, 0x6c, 0x6c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x22, 0x9f, 0x01, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x62, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f,
+	0x65, 0x78, 0x74, 0x2e, 0x65, 0x46, 0x65, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x22, 0xf3, 0x03, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x65,
+	0x67, 0x12, 0x4a, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x4d, 0x0a,
+	0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x66,
+	0x65, 0x65, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x2a,
+	0x0a, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x22, 0xdd, 0x02, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x12, 0x4d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x6f, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x70, 0x6f, 0x74, 0x22, 0x50, 0x0a, 0x06, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x8c, 0x02, 0x0a,
+	0x0e, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4a, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x57, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39,
+	0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x12, 0x55, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x70, 0x6c, 0x6f, 0x74, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x86, 0x04, 0x0a, 0x06,
+	0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x45,
+	0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x45, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x43, 0x0a, 0x03,
+	0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x03, 0x6c, 0x6f,
+	0x77, 0x12, 0x47, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x70, 0x65, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x20,
+	0x0a, 0x0b, 0x68, 0x69, 0x67, 0x68, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x69, 0x67, 0x68, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x77, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x22, 0x54, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12,
+	0x49, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x2a, 0x4f, 0x0a, 0x10, 0x65, 0x43,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4f, 0x4e, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x00, 0x12, 0x10,
+	0x0a, 0x0c, 0x46, 0x49, 0x56, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x4f, 0x4e, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x03, 0x2a, 0x29, 0x0a, 0x0a, 0x65,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54,
+	0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x53, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x2a, 0x23, 0x0a, 0x09, 0x65, 0x46, 0x65, 0x65, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x41, 0x4e, 0x4b, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x10, 0x01, 0x2a, 0xfd, 0x02, 0x0a, 0x13,
+	0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x45, 0x52,
+	0x5f, 0x50, 0x41, 0x49, 0x52, 0x10, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c,
+	0x10, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x41,
+	0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x10, 0x66,
+	0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45,
+	0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x49, 0x52,
+	0x10, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x4d,
+	0x4f, 0x55, 0x4e, 0x54, 0x10, 0x68, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x10, 0x69, 0x12, 0x11,
+	0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10,
+	0x6a, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x6b, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x4f, 0x55,
+	0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x6c, 0x12, 0x14,
+	0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x4c, 0x45,
+	0x47, 0x53, 0x10, 0x6d, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54,
+	0x4f, 0x4f, 0x5f, 0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x10, 0x6e, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x41,
+	0x52, 0x47, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42,
+	0x4c, 0x45, 0x10, 0x6f, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43,
+	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x50, 0x54, 0x48, 0x10, 0x70, 0x12, 0x1d, 0x0a, 0x19,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x44, 0x56, 0x45, 0x52, 0x54, 0x49, 0x53,
+	0x45, 0x52, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0x71, 0x32, 0xe9, 0x09, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x12, 0x77, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38,
+	0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x2e, 0x62, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x74, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x36, 0x2e, 0x62,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x75, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x76, 0x0a, 0x0a,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x38, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x7e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x12, 0x38, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x65, 0x70, 0x74, 0x68, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x39, 0x2e, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x72, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x68, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x39, 0x2e, 0x62,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_converter_ext_proto_rawDescOnce sync.Once
+	file_proto_converter_ext_proto_rawDescData = file_proto_converter_ext_proto_rawDesc
+)
+
+func file_proto_converter_ext_proto_rawDescGZIP() []byte {
+	file_proto_converter_ext_proto_rawDescOnce.Do(func() {
+		file_proto_converter_ext_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_converter_ext_proto_rawDescData)
+	})
+	return file_proto_converter_ext_proto_rawDescData
+}
+
+var file_proto_converter_ext_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_converter_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_proto_converter_ext_proto_goTypes = []interface{}{
+	(ECandleTimeframe)(0),              // 0: binance_converter.backend.converter_ext.eCandleTimeframe
+	(ETradeSide)(0),                    // 1: binance_converter.backend.converter_ext.eTradeSide
+	(EFeeScope)(0),                     // 2: binance_converter.backend.converter_ext.eFeeScope
+	(AdditionalErrorCode)(0),           // 3: binance_converter.backend.converter_ext.AdditionalErrorCode
+	(*ExchangeRequest)(nil),            // 4: binance_converter.backend.converter_ext.exchangeRequest
+	(*DecimalExchange)(nil),            // 5: binance_converter.backend.converter_ext.decimalExchange
+	(*RequiredAmountRequest)(nil),      // 6: binance_converter.backend.converter_ext.requiredAmountRequest
+	(*RequiredAmount)(nil),             // 7: binance_converter.backend.converter_ext.requiredAmount
+	(*DepthFill)(nil),                  // 8: binance_converter.backend.converter_ext.depthFill
+	(*DepthQuote)(nil),                 // 9: binance_converter.backend.converter_ext.depthQuote
+	(*OffersRequest)(nil),              // 10: binance_converter.backend.converter_ext.offersRequest
+	(*Advertiser)(nil),                 // 11: binance_converter.backend.converter_ext.advertiser
+	(*AdvertiserFilter)(nil),           // 12: binance_converter.backend.converter_ext.advertiserFilter
+	(*Offer)(nil),                      // 13: binance_converter.backend.converter_ext.offer
+	(*Offers)(nil),                     // 14: binance_converter.backend.converter_ext.offers
+	(*RoutesRequest)(nil),              // 15: binance_converter.backend.converter_ext.routesRequest
+	(*Fee)(nil),                        // 16: binance_converter.backend.converter_ext.fee
+	(*RouteLeg)(nil),                   // 17: binance_converter.backend.converter_ext.routeLeg
+	(*Route)(nil),                      // 18: binance_converter.backend.converter_ext.route
+	(*Routes)(nil),                     // 19: binance_converter.backend.converter_ext.routes
+	(*CandlesRequest)(nil),             // 20: binance_converter.backend.converter_ext.candlesRequest
+	(*Candle)(nil),                     // 21: binance_converter.backend.converter_ext.candle
+	(*Candles)(nil),                    // 22: binance_converter.backend.converter_ext.candles
+	(*converter.ConverterPair)(nil),    // 23: binance_converter.backend_api.converter.converterPair
+	(*converter.Exchange)(nil),         // 24: binance_converter.backend_api.converter.exchange
+	(*currencies.FullCurrency)(nil),    // 25: binance_converter.backend_api.currencies.fullCurrency
+	(*exchange_plot.TimeInterval)(nil), // 26: binance_converter.backend_api.exchange_plot.timeInterval
+	(*timestamppb.Timestamp)(nil),      // 27: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 28: google.protobuf.Empty
+}
+var file_proto_converter_ext_proto_depIdxs = []int32{
+	23, // 0: binance_converter.backend.converter_ext.exchangeRequest.pair:type_name -> binance_converter.backend_api.converter.converterPair
+	1,  // 1: binance_converter.backend.converter_ext.exchangeRequest.side:type_name -> binance_converter.backend.converter_ext.eTradeSide
+	23, // 2: binance_converter.backend.converter_ext.requiredAmountRequest.pair:type_name -> binance_converter.backend_api.converter.converterPair
+	1,  // 3: binance_converter.backend.converter_ext.requiredAmountRequest.side:type_name -> binance_converter.backend.converter_ext.eTradeSide
+	18, // 4: binance_converter.backend.converter_ext.requiredAmount.route:type_name -> binance_converter.backend.converter_ext.route
+	13, // 5: binance_converter.backend.converter_ext.depthFill.offer:type_name -> binance_converter.backend.converter_ext.offer
+	23, // 6: binance_converter.backend.converter_ext.depthQuote.pair:type_name -> binance_converter.backend_api.converter.converterPair
+	24, // 7: binance_converter.backend.converter_ext.depthQuote.exchange:type_name -> binance_converter.backend_api.converter.exchange
+	24, // 8: binance_converter.backend.converter_ext.depthQuote.bestExchange:type_name -> binance_converter.backend_api.converter.exchange
+	8,  // 9: binance_converter.backend.converter_ext.depthQuote.fills:type_name -> binance_converter.backend.converter_ext.depthFill
+	23, // 10: binance_converter.backend.converter_ext.offersRequest.pair:type_name -> binance_converter.backend_api.converter.converterPair
+	1,  // 11: binance_converter.backend.converter_ext.offersRequest.side:type_name -> binance_converter.backend.converter_ext.eTradeSide
+	11, // 12: binance_converter.backend.converter_ext.offer.advertiser:type_name -> binance_converter.backend.converter_ext.advertiser
+	24, // 13: binance_converter.backend.converter_ext.offer.exchange:type_name -> binance_converter.backend_api.converter.exchange
+	13, // 14: binance_converter.backend.converter_ext.offers.offers:type_name -> binance_converter.backend.converter_ext.offer
+	25, // 15: binance_converter.backend.converter_ext.routesRequest.source:type_name -> binance_converter.backend_api.currencies.fullCurrency
+	25, // 16: binance_converter.backend.converter_ext.routesRequest.target:type_name -> binance_converter.backend_api.currencies.fullCurrency
+	2,  // 17: binance_converter.backend.converter_ext.fee.scope:type_name -> binance_converter.backend.converter_ext.eFeeScope
+	23, // 18: binance_converter.backend.converter_ext.routeLeg.pair:type_name -> binance_converter.backend_api.converter.converterPair
+	24, // 19: binance_converter.backend.converter_ext.routeLeg.exchange:type_name -> binance_converter.backend_api.converter.exchange
+	16, // 20: binance_converter.backend.converter_ext.routeLeg.fees:type_name -> binance_converter.backend.converter_ext.fee
+	23, // 21: binance_converter.backend.converter_ext.route.pair:type_name -> binance_converter.backend_api.converter.converterPair
+	24, // 22: binance_converter.backend.converter_ext.route.exchange:type_name -> binance_converter.backend_api.converter.exchange
+	17, // 23: binance_converter.backend.converter_ext.route.legs:type_name -> binance_converter.backend.converter_ext.routeLeg
+	18, // 24: binance_converter.backend.converter_ext.routes.routes:type_name -> binance_converter.backend.converter_ext.route
+	23, // 25: binance_converter.backend.converter_ext.candlesRequest.pair:type_name -> binance_converter.backend_api.converter.converterPair
+	0,  // 26: binance_converter.backend.converter_ext.candlesRequest.timeframe:type_name -> binance_converter.backend.converter_ext.eCandleTimeframe
+	26, // 27: binance_converter.backend.converter_ext.candlesRequest.interval:type_name -> binance_converter.backend_api.exchange_plot.timeInterval
+	27, // 28: binance_converter.backend.converter_ext.candle.openTime:type_name -> google.protobuf.Timestamp
+	24, // 29: binance_converter.backend.converter_ext.candle.open:type_name -> binance_converter.backend_api.converter.exchange
+	24, // 30: binance_converter.backend.converter_ext.candle.high:type_name -> binance_converter.backend_api.converter.exchange
+	24, // 31: binance_converter.backend.converter_ext.candle.low:type_name -> binance_converter.backend_api.converter.exchange
+	24, // 32: binance_converter.backend.converter_ext.candle.close:type_name -> binance_converter.backend_api.converter.exchange
+	21, // 33: binance_converter.backend.converter_ext.candles.candles:type_name -> binance_converter.backend.converter_ext.candle
+	20, // 34: binance_converter.backend.converter_ext.converterExt.GetCandles:input_type -> binance_converter.backend.converter_ext.candlesRequest
+	4,  // 35: binance_converter.backend.converter_ext.converterExt.GetExchangeForAmount:input_type -> binance_converter.backend.converter_ext.exchangeRequest
+	4,  // 36: binance_converter.backend.converter_ext.converterExt.GetDecimalExchangeForAmount:input_type -> binance_converter.backend.converter_ext.exchangeRequest
+	10, // 37: binance_converter.backend.converter_ext.converterExt.GetOffers:input_type -> binance_converter.backend.converter_ext.offersRequest
+	15, // 38: binance_converter.backend.converter_ext.converterExt.FindRoutes:input_type -> binance_converter.backend.converter_ext.routesRequest
+	4,  // 39: binance_converter.backend.converter_ext.converterExt.QuoteRoute:input_type -> binance_converter.backend.converter_ext.exchangeRequest
+	6,  // 40: binance_converter.backend.converter_ext.converterExt.GetRequiredAmount:input_type -> binance_converter.backend.converter_ext.requiredAmountRequest
+	4,  // 41: binance_converter.backend.converter_ext.converterExt.GetDepthQuote:input_type -> binance_converter.backend.converter_ext.exchangeRequest
+	12, // 42: binance_converter.backend.converter_ext.converterExt.SetAdvertiserFilter:input_type -> binance_converter.backend.converter_ext.advertiserFilter
+	28, // 43: binance_converter.backend.converter_ext.converterExt.GetAdvertiserFilter:input_type -> google.protobuf.Empty
+	22, // 44: binance_converter.backend.converter_ext.converterExt.GetCandles:output_type -> binance_converter.backend.converter_ext.candles
+	24, // 45: binance_converter.backend.converter_ext.converterExt.GetExchangeForAmount:output_type -> binance_converter.backend_api.converter.exchange
+	5,  // 46: binance_converter.backend.converter_ext.converterExt.GetDecimalExchangeForAmount:output_type -> binance_converter.backend.converter_ext.decimalExchange
+	14, // 47: binance_converter.backend.converter_ext.converterExt.GetOffers:output_type -> binance_converter.backend.converter_ext.offers
+	19, // 48: binance_converter.backend.converter_ext.converterExt.FindRoutes:output_type -> binance_converter.backend.converter_ext.routes
+	18, // 49: binance_converter.backend.converter_ext.converterExt.QuoteRoute:output_type -> binance_converter.backend.converter_ext.route
+	7,  // 50: binance_converter.backend.converter_ext.converterExt.GetRequiredAmount:output_type -> binance_converter.backend.converter_ext.requiredAmount
+	9,  // 51: binance_converter.backend.converter_ext.converterExt.GetDepthQuote:output_type -> binance_converter.backend.converter_ext.depthQuote
+	28, // 52: binance_converter.backend.converter_ext.converterExt.SetAdvertiserFilter:output_type -> google.protobuf.Empty
+	12, // 53: binance_converter.backend.converter_ext.converterExt.GetAdvertiserFilter:output_type -> binance_converter.backend.converter_ext.advertiserFilter
+	44, // [44:54] is the sub-list for method output_type
+	34, // [34:44] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_proto_converter_ext_proto_init() }
+func file_proto_converter_ext_proto_init() {
+	if File_proto_converter_ext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_converter_ext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecimalExchange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequiredAmountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequiredAmount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepthFill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepthQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OffersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Advertiser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdvertiserFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Offer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Offers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoutesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteLeg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Route); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Routes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CandlesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_converter_ext_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_converter_ext_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_converter_ext_proto_goTypes,
+		DependencyIndexes: file_proto_converter_ext_proto_depIdxs,
+		EnumInfos:         file_proto_converter_ext_proto_enumTypes,
+		MessageInfos:      file_proto_converter_ext_proto_msgTypes,
+	}.Build()
+	File_proto_converter_ext_proto = out.File
+	file_proto_converter_ext_proto_rawDesc = nil
+	file_proto_converter_ext_proto_goTypes = nil
+	file_proto_converter_ext_proto_depIdxs = nil
+}
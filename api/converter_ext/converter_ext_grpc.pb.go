@@ -0,0 +1,443 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.9
+// source: proto/converter_ext.proto
+
+package converter_ext
+
+import (
+	context "context"
+	converter "github.com/binance-converter/backend-api/api/converter"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ConverterExtClient is the client API for ConverterExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConverterExtClient interface {
+	GetCandles(ctx context.Context, in *CandlesRequest, opts ...grpc.CallOption) (*Candles, error)
+	GetExchangeForAmount(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*converter.Exchange, error)
+	GetDecimalExchangeForAmount(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*DecimalExchange, error)
+	GetOffers(ctx context.Context, in *OffersRequest, opts ...grpc.CallOption) (*Offers, error)
+	FindRoutes(ctx context.Context, in *RoutesRequest, opts ...grpc.CallOption) (*Routes, error)
+	// QuoteRoute quotes the converter pair with the breakdown of legs and fees
+	QuoteRoute(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*Route, error)
+	// GetRequiredAmount returns amount of the first currency required to receive target amount of
+	// the last one
+	GetRequiredAmount(ctx context.Context, in *RequiredAmountRequest, opts ...grpc.CallOption) (*RequiredAmount, error)
+	// GetDepthQuote splits amount of the pair of two currencies between several offers of binance
+	// p2p order book
+	GetDepthQuote(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*DepthQuote, error)
+	// SetAdvertiserFilter stores advertiser filter applied to all quotes of the user
+	SetAdvertiserFilter(ctx context.Context, in *AdvertiserFilter, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAdvertiserFilter(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AdvertiserFilter, error)
+}
+
+type converterExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConverterExtClient(cc grpc.ClientConnInterface) ConverterExtClient {
+	return &converterExtClient{cc}
+}
+
+func (c *converterExtClient) GetCandles(ctx context.Context, in *CandlesRequest, opts ...grpc.CallOption) (*Candles, error) {
+	out := new(Candles)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/GetCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterExtClient) GetExchangeForAmount(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*converter.Exchange, error) {
+	out := new(converter.Exchange)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/GetExchangeForAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterExtClient) GetDecimalExchangeForAmount(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*DecimalExchange, error) {
+	out := new(DecimalExchange)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/GetDecimalExchangeForAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterExtClient) GetOffers(ctx context.Context, in *OffersRequest, opts ...grpc.CallOption) (*Offers, error) {
+	out := new(Offers)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/GetOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterExtClient) FindRoutes(ctx context.Context, in *RoutesRequest, opts ...grpc.CallOption) (*Routes, error) {
+	out := new(Routes)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/FindRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterExtClient) QuoteRoute(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*Route, error) {
+	out := new(Route)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/QuoteRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterExtClient) GetRequiredAmount(ctx context.Context, in *RequiredAmountRequest, opts ...grpc.CallOption) (*RequiredAmount, error) {
+	out := new(RequiredAmount)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/GetRequiredAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterExtClient) GetDepthQuote(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*DepthQuote, error) {
+	out := new(DepthQuote)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/GetDepthQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterExtClient) SetAdvertiserFilter(ctx context.Context, in *AdvertiserFilter, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/SetAdvertiserFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterExtClient) GetAdvertiserFilter(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AdvertiserFilter, error) {
+	out := new(AdvertiserFilter)
+	err := c.cc.Invoke(ctx, "/binance_converter.backend.converter_ext.converterExt/GetAdvertiserFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConverterExtServer is the server API for ConverterExt service.
+// All implementations must embed UnimplementedConverterExtServer
+// for forward compatibility
+type ConverterExtServer interface {
+	GetCandles(context.Context, *CandlesRequest) (*Candles, error)
+	GetExchangeForAmount(context.Context, *ExchangeRequest) (*converter.Exchange, error)
+	GetDecimalExchangeForAmount(context.Context, *ExchangeRequest) (*DecimalExchange, error)
+	GetOffers(context.Context, *OffersRequest) (*Offers, error)
+	FindRoutes(context.Context, *RoutesRequest) (*Routes, error)
+	// QuoteRoute quotes the converter pair with the breakdown of legs and fees
+	QuoteRoute(context.Context, *ExchangeRequest) (*Route, error)
+	// GetRequiredAmount returns amount of the first currency required to receive target amount of
+	// the last one
+	GetRequiredAmount(context.Context, *RequiredAmountRequest) (*RequiredAmount, error)
+	// GetDepthQuote splits amount of the pair of two currencies between several offers of binance
+	// p2p order book
+	GetDepthQuote(context.Context, *ExchangeRequest) (*DepthQuote, error)
+	// SetAdvertiserFilter stores advertiser filter applied to all quotes of the user
+	SetAdvertiserFilter(context.Context, *AdvertiserFilter) (*emptypb.Empty, error)
+	GetAdvertiserFilter(context.Context, *emptypb.Empty) (*AdvertiserFilter, error)
+	mustEmbedUnimplementedConverterExtServer()
+}
+
+// UnimplementedConverterExtServer must be embedded to have forward compatible implementations.
+type UnimplementedConverterExtServer struct {
+}
+
+func (UnimplementedConverterExtServer) GetCandles(context.Context, *CandlesRequest) (*Candles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandles not implemented")
+}
+func (UnimplementedConverterExtServer) GetExchangeForAmount(context.Context, *ExchangeRequest) (*converter.Exchange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeForAmount not implemented")
+}
+func (UnimplementedConverterExtServer) GetDecimalExchangeForAmount(context.Context, *ExchangeRequest) (*DecimalExchange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDecimalExchangeForAmount not implemented")
+}
+func (UnimplementedConverterExtServer) GetOffers(context.Context, *OffersRequest) (*Offers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOffers not implemented")
+}
+func (UnimplementedConverterExtServer) FindRoutes(context.Context, *RoutesRequest) (*Routes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRoutes not implemented")
+}
+func (UnimplementedConverterExtServer) QuoteRoute(context.Context, *ExchangeRequest) (*Route, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteRoute not implemented")
+}
+func (UnimplementedConverterExtServer) GetRequiredAmount(context.Context, *RequiredAmountRequest) (*RequiredAmount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRequiredAmount not implemented")
+}
+func (UnimplementedConverterExtServer) GetDepthQuote(context.Context, *ExchangeRequest) (*DepthQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepthQuote not implemented")
+}
+func (UnimplementedConverterExtServer) SetAdvertiserFilter(context.Context, *AdvertiserFilter) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdvertiserFilter not implemented")
+}
+func (UnimplementedConverterExtServer) GetAdvertiserFilter(context.Context, *emptypb.Empty) (*AdvertiserFilter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdvertiserFilter not implemented")
+}
+func (UnimplementedConverterExtServer) mustEmbedUnimplementedConverterExtServer() {}
+
+// UnsafeConverterExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConverterExtServer will
+// result in compilation errors.
+type UnsafeConverterExtServer interface {
+	mustEmbedUnimplementedConverterExtServer()
+}
+
+func RegisterConverterExtServer(s grpc.ServiceRegistrar, srv ConverterExtServer) {
+	s.RegisterService(&ConverterExt_ServiceDesc, srv)
+}
+
+func _ConverterExt_GetCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).GetCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/GetCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).GetCandles(ctx, req.(*CandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConverterExt_GetExchangeForAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).GetExchangeForAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/GetExchangeForAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).GetExchangeForAmount(ctx, req.(*ExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConverterExt_GetDecimalExchangeForAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).GetDecimalExchangeForAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/GetDecimalExchangeForAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).GetDecimalExchangeForAmount(ctx, req.(*ExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConverterExt_GetOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).GetOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/GetOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).GetOffers(ctx, req.(*OffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConverterExt_FindRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).FindRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/FindRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).FindRoutes(ctx, req.(*RoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConverterExt_QuoteRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).QuoteRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/QuoteRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).QuoteRoute(ctx, req.(*ExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConverterExt_GetRequiredAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequiredAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).GetRequiredAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/GetRequiredAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).GetRequiredAmount(ctx, req.(*RequiredAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConverterExt_GetDepthQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).GetDepthQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/GetDepthQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).GetDepthQuote(ctx, req.(*ExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConverterExt_SetAdvertiserFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdvertiserFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).SetAdvertiserFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/SetAdvertiserFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).SetAdvertiserFilter(ctx, req.(*AdvertiserFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConverterExt_GetAdvertiserFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterExtServer).GetAdvertiserFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance_converter.backend.converter_ext.converterExt/GetAdvertiserFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterExtServer).GetAdvertiserFilter(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConverterExt_ServiceDesc is the grpc.ServiceDesc for ConverterExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConverterExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "binance_converter.backend.converter_ext.converterExt",
+	HandlerType: (*ConverterExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCandles",
+			Handler:    _ConverterExt_GetCandles_Handler,
+		},
+		{
+			MethodName: "GetExchangeForAmount",
+			Handler:    _ConverterExt_GetExchangeForAmount_Handler,
+		},
+		{
+			MethodName: "GetDecimalExchangeForAmount",
+			Handler:    _ConverterExt_GetDecimalExchangeForAmount_Handler,
+		},
+		{
+			MethodName: "GetOffers",
+			Handler:    _ConverterExt_GetOffers_Handler,
+		},
+		{
+			MethodName: "FindRoutes",
+			Handler:    _ConverterExt_FindRoutes_Handler,
+		},
+		{
+			MethodName: "QuoteRoute",
+			Handler:    _ConverterExt_QuoteRoute_Handler,
+		},
+		{
+			MethodName: "GetRequiredAmount",
+			Handler:    _ConverterExt_GetRequiredAmount_Handler,
+		},
+		{
+			MethodName: "GetDepthQuote",
+			Handler:    _ConverterExt_GetDepthQuote_Handler,
+		},
+		{
+			MethodName: "SetAdvertiserFilter",
+			Handler:    _ConverterExt_SetAdvertiserFilter_Handler,
+		},
+		{
+			MethodName: "GetAdvertiserFilter",
+			Handler:    _ConverterExt_GetAdvertiserFilter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/converter_ext.proto",
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"github.com/binance-converter/backend/core"
+	"github.com/binance-converter/backend/internal/service"
+	userDbMemory "github.com/binance-converter/backend/internal/storage/user_db/memory"
+	userDbPostgres "github.com/binance-converter/backend/internal/storage/user_db/postgres"
+	userDbSqlite "github.com/binance-converter/backend/internal/storage/user_db/sqlite"
+	"github.com/binance-converter/backend/pkg/binance_api"
+	"github.com/binance-converter/backend/pkg/http_provider"
+	"github.com/binance-converter/backend/schema"
+	sqliteSchema "github.com/binance-converter/backend/schema/sqlite"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+const (
+	userDbDriverPostgres = "postgres"
+	userDbDriverSqlite   = "sqlite"
+	userDbDriverMemory   = "memory"
+
+	defaultSqliteUserDbPath = "user.db"
+)
+
+// appUserDb is the storage of all services, it is implemented by postgres, sqlite and memory
+// storages
+type appUserDb interface {
+	service.AuthDB
+	service.ConverterUserDb
+	service.CurrencyUserDb
+	service.CatalogUserDb
+	service.FeesUserDb
+	service.AlertsUserDb
+	service.ArbitrageUserDb
+	service.ThresholdWatcherUserDb
+	service.ExchangeRateCollectorUserDb
+	service.ExchangePlotUserDb
+	service.CandlesUserDb
+	GetUsers(ctx context.Context) ([]core.User, error)
+	SetAdmin(ctx context.Context, userId int, isAdmin bool) error
+}
+
+// appMigrator applies migrations of the storage, it is implemented by postgres and sqlite storages
+type appMigrator interface {
+	Baseline(ctx context.Context, version int) error
+	Up(ctx context.Context) ([]int, error)
+	Down(ctx context.Context, steps int) ([]int, error)
+	Version(ctx context.Context) (int, error)
+}
+
+// app is the storage and services shared by commands, background jobs are started only by serve
+type app struct {
+	cfg    appConfig
+	userDb appUserDb
+	// migrator is nil when storage has no schema
+	migrator appMigrator
+
+	binanceApi   *binance_api.BinanceApi
+	auth         *service.Auth
+	fees         *service.Fees
+	converter    *service.Converter
+	currency     *service.Currency
+	exchangePlot *service.ExchangePlot
+	candles      *service.Candles
+	depth        *service.Depth
+	routeFinder  *service.RouteFinder
+	catalog      *service.Catalog
+}
+
+func newApp(ctx context.Context, cfg appConfig) *app {
+	var userDb appUserDb
+	var migrator appMigrator
+
+	switch cfg.UserDb.Driver {
+	case "", userDbDriverPostgres:
+		userDb, migrator = newPostgresUserDb(ctx, cfg)
+	case userDbDriverSqlite:
+		userDb, migrator = newSqliteUserDb(ctx, cfg)
+	case userDbDriverMemory:
+		userDb = newMemoryUserDb(ctx)
+	default:
+		logrus.WithFields(logrus.Fields{
+			"driver": cfg.UserDb.Driver,
+		}).Fatal("unknown user db driver")
+	}
+
+	bApi, err := binance_api.NewBinanceApi(binance_api.Config{
+		RequestsPerSecond: cfg.BinanceApi.RequestsPerSecond,
+		Burst:             cfg.BinanceApi.Burst,
+		Attempts:          cfg.BinanceApi.Attempts,
+		BaseDelay:         time.Duration(cfg.BinanceApi.BaseDelayMillis) * time.Millisecond,
+		MaxDelay:          time.Duration(cfg.BinanceApi.MaxDelayMillis) * time.Millisecond,
+		Timeout:           time.Duration(cfg.BinanceApi.TimeoutSeconds) * time.Second,
+		DepthPages:        cfg.BinanceApi.DepthPages,
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("error create binance api")
+	}
+	providers := service.NewProviderRegistry()
+	if err := providers.Register(core.ProviderBinanceP2P, bApi); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"provider": core.ProviderBinanceP2P,
+			"error":    err,
+		}).Fatal("error register provider")
+	}
+	if cfg.HttpProvider.Url != "" {
+		httpProvider := http_provider.NewHttpProvider(http_provider.Config{
+			Url:     cfg.HttpProvider.Url,
+			Timeout: time.Duration(cfg.HttpProvider.TimeoutSeconds) * time.Second,
+		})
+		if err := providers.Register(core.ProviderLocalHttp, httpProvider); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"provider": core.ProviderLocalHttp,
+				"error":    err,
+			}).Fatal("error register provider")
+		}
+	}
+
+	quoteCache := service.NewQuoteCache(providers, service.QuoteCacheConfig{
+		TTL:      time.Duration(cfg.QuoteCache.TTLSeconds) * time.Second,
+		MaxStale: time.Duration(cfg.QuoteCache.MaxStaleSeconds) * time.Second,
+		PairTTLs: quoteCachePairTTLs(cfg),
+	})
+
+	feesService := service.NewFees(userDb, time.Duration(cfg.Fees.TTLSeconds)*time.Second)
+	converterService := service.NewConverter(quoteCache, userDb, feesService)
+
+	return &app{
+		cfg:          cfg,
+		userDb:       userDb,
+		migrator:     migrator,
+		binanceApi:   bApi,
+		auth:         service.NewAuth(userDb),
+		fees:         feesService,
+		converter:    converterService,
+		currency:     service.NewCurrency(userDb),
+		exchangePlot: service.NewExchangePlot(userDb),
+		candles:      service.NewCandles(userDb),
+		depth:        service.NewDepth(bApi, userDb),
+		routeFinder:  service.NewRouteFinder(userDb, converterService),
+		catalog:      service.NewCatalog(userDb, converterService),
+	}
+}
+
+func quoteCachePairTTLs(cfg appConfig) []service.QuoteCachePairTTL {
+	pairTTLs := make([]service.QuoteCachePairTTL, 0, len(cfg.QuoteCache.PairTTLSeconds))
+	for pair, seconds := range cfg.QuoteCache.PairTTLSeconds {
+		converterPair, err := parseConverterPair(strings.Fields(pair))
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"converterPair": pair,
+				"error":         err,
+			}).Fatal("invalid converter pair of quote cache ttl")
+		}
+		pairTTLs = append(pairTTLs, service.QuoteCachePairTTL{
+			ConverterPair: converterPair,
+			TTL:           time.Duration(seconds) * time.Second,
+		})
+	}
+	return pairTTLs
+}
+
+func newPostgresUserDb(ctx context.Context, cfg appConfig) (*userDbPostgres.UserDb,
+	*userDbPostgres.Migrator) {
+	userDbConfig := userDbPostgres.Config{
+		Host:     *cfg.PostgresUserDb.Host,
+		Port:     *cfg.PostgresUserDb.Port,
+		Username: cfg.PostgresUserDb.Username,
+		Password: cfg.PostgresUserDb.Password,
+		DBName:   *cfg.PostgresUserDb.DBName,
+	}
+
+	postgresDb, err := userDbPostgres.NewPostgresDB(ctx, userDbConfig)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("error connect to postgres database")
+	}
+
+	migrator, err := userDbPostgres.NewMigrator(postgresDb, schema.Migrations)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("error load migrations")
+	}
+
+	transaction := userDbPostgres.NewTransaction(postgresDb)
+
+	return userDbPostgres.NewUserDB(postgresDb, transaction), migrator
+}
+
+// newSqliteUserDb opens the database file, it is created when it does not exist, converter pairs
+// are added by `seed` command as for postgres
+func newSqliteUserDb(ctx context.Context, cfg appConfig) (*userDbSqlite.UserDb,
+	*userDbSqlite.Migrator) {
+	path := cfg.SqliteUserDb.Path
+	if path == "" {
+		path = defaultSqliteUserDbPath
+	}
+
+	sqliteDb, err := userDbSqlite.NewSqliteDB(ctx, userDbSqlite.Config{Path: path})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"path":  path,
+			"error": err,
+		}).Fatal("error open sqlite database")
+	}
+
+	migrator, err := userDbSqlite.NewMigrator(sqliteDb, sqliteSchema.Migrations)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("error load migrations")
+	}
+
+	transaction := userDbSqlite.NewTransaction(sqliteDb)
+
+	return userDbSqlite.NewUserDB(sqliteDb, transaction), migrator
+}
+
+// newMemoryUserDb returns empty storage with default converter pairs, data is lost when the process
+// exits
+func newMemoryUserDb(ctx context.Context) *userDbMemory.UserDb {
+	userDb := userDbMemory.NewUserDB()
+	for _, converterPair := range core.ConverterPairs {
+		if _, err := userDb.AddConverterPairIfHasNot(ctx, converterPair); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"converterPair": converterPair,
+				"error":         err,
+			}).Fatal("error seed converter pair")
+		}
+	}
+	logrus.Warn("user db is kept in memory, data is lost when the process exits")
+	return userDb
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+var errorInvalidCurrency = errors.New("invalid currency, expected CODE or CODE:BANK")
+
+// runSeed adds default currencies and converter pairs, pairs which already exist are kept as is
+func runSeed(ctx context.Context, a *app) {
+	for _, converterPair := range core.ConverterPairs {
+		id, err := a.userDb.AddConverterPairIfHasNot(ctx, converterPair)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"converterPair": converterPair,
+				"error":         err,
+			}).Fatal("error seed converter pair")
+		}
+		fmt.Printf("%d\t%s\n", id, formatConverterPair(converterPair))
+	}
+}
+
+func runUsers(ctx context.Context, a *app, args []string) {
+	if len(args) == 0 {
+		logrus.Fatal("usage: users list | set-admin <id> <bool>")
+	}
+
+	switch args[0] {
+	case "list":
+		users, err := a.userDb.GetUsers(ctx)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err,
+			}).Fatal("error get users")
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tCHAT ID\tUSER NAME\tNAME\tLANGUAGE\tADMIN")
+		for _, user := range users {
+			chatId := ""
+			if user.ChatId != nil {
+				chatId = strconv.FormatInt(*user.ChatId, 10)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%t\n", user.Id, chatId,
+				stringOrEmpty(user.UserName), strings.TrimSpace(user.FirstName+" "+user.LastName),
+				stringOrEmpty(user.LanguageCode), user.IsAdmin)
+		}
+		w.Flush()
+	case "set-admin":
+		if len(args) != 3 {
+			logrus.Fatal("usage: users set-admin <id> <bool>")
+		}
+		userId, err := strconv.Atoi(args[1])
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"userId": args[1],
+			}).Fatal("invalid user id")
+		}
+		isAdmin, err := strconv.ParseBool(args[2])
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"isAdmin": args[2],
+			}).Fatal("invalid admin flag")
+		}
+		if err := a.userDb.SetAdmin(ctx, userId, isAdmin); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"userId": userId,
+				"error":  err,
+			}).Fatal("error set admin role of user")
+		}
+	default:
+		logrus.WithFields(logrus.Fields{
+			"command": args[0],
+		}).Fatal("unknown users command")
+	}
+}
+
+func runPairs(ctx context.Context, a *app, args []string) {
+	if len(args) == 0 {
+		logrus.Fatal("usage: pairs list | add <currency>...")
+	}
+
+	switch args[0] {
+	case "list":
+		pairs, err := a.userDb.GetCatalogConverterPairs(ctx)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err,
+			}).Fatal("error get converter pairs")
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "PAIR\tDISABLED")
+		for _, pair := range pairs {
+			fmt.Fprintf(w, "%s\t%t\n", formatConverterPair(pair.ConverterPair), pair.Disabled)
+		}
+		w.Flush()
+	case "add":
+		converterPair, err := parseConverterPair(args[1:])
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"args":  args[1:],
+				"error": err,
+			}).Fatal("invalid converter pair")
+		}
+		if err := a.catalog.ImportConverterPair(ctx, converterPair); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"converterPair": converterPair,
+				"error":         err,
+			}).Fatal("error add converter pair")
+		}
+	default:
+		logrus.WithFields(logrus.Fields{
+			"command": args[0],
+		}).Fatal("unknown pairs command")
+	}
+}
+
+// runQuote quotes the converter pair without advertiser filters of users and prints legs of the
+// route with their fees
+func runQuote(ctx context.Context, a *app, args []string) {
+	flags := flag.NewFlagSet("quote", flag.ExitOnError)
+	amount := flags.String("amount", "0",
+		"amount of the first currency, spot quote without fixed fees when 0")
+	side := flags.String("side", "auto", "trade side of a pair of two currencies: auto, buy, sell")
+	providers := flags.String("providers", "", "comma separated providers, all when empty")
+	_ = flags.Parse(args)
+
+	converterPair, err := parseConverterPair(flags.Args())
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"args":  flags.Args(),
+			"error": err,
+		}).Fatal("invalid converter pair")
+	}
+	converterPair.Amount, err = decimal.NewFromString(*amount)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"amount": *amount,
+			"error":  err,
+		}).Fatal("invalid amount")
+	}
+	switch *side {
+	case "auto":
+		converterPair.TradeSide = core.TradeSideAuto
+	case "buy":
+		converterPair.TradeSide = core.TradeSideBuy
+	case "sell":
+		converterPair.TradeSide = core.TradeSideSell
+	default:
+		logrus.WithFields(logrus.Fields{
+			"side": *side,
+		}).Fatal("invalid trade side")
+	}
+	if *providers != "" {
+		for _, provider := range strings.Split(*providers, ",") {
+			converterPair.Providers = append(converterPair.Providers,
+				core.ProviderName(strings.TrimSpace(provider)))
+		}
+	}
+
+	quote, err := a.converter.QuoteRoute(ctx, converterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err,
+		}).Fatal("error quote converter pair")
+	}
+
+	spot := ""
+	if quote.Spot {
+		spot = "\tspot"
+	}
+	fmt.Printf("%s\texchange %s\trate %s%s\n", formatConverterPair(converterPair),
+		quote.Exchange.String(), quote.Rate.String(), spot)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LEG\tPROVIDER\tEXCHANGE\tRATE\tAMOUNT IN\tAMOUNT OUT\tFEES")
+	for _, leg := range quote.Legs {
+		fees := make([]string, 0, len(leg.Fees))
+		for _, fee := range leg.Fees {
+			fees = append(fees, fmt.Sprintf("%s %s", fee.Key, fee.Amount.String()))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", formatConverterPair(leg.ConverterPair),
+			leg.Provider, leg.Exchange.String(), leg.Rate.String(), leg.AmountIn.String(),
+			leg.AmountOut.String(), strings.Join(fees, ", "))
+	}
+	w.Flush()
+}
+
+// parseConverterPair parses currencies of CODE form for crypto currencies and CODE:BANK form for
+// classic ones
+func parseConverterPair(args []string) (core.ConverterPair, error) {
+	if len(args) < 2 {
+		return core.ConverterPair{}, core.ErrorConverterInvalidConverterPair
+	}
+
+	var converterPair core.ConverterPair
+	for _, arg := range args {
+		code, bank, classic := strings.Cut(arg, ":")
+		if code == "" || (classic && bank == "") {
+			return core.ConverterPair{}, errorInvalidCurrency
+		}
+		currency := core.FullCurrency{
+			CurrencyType: core.CurrencyTypeCrypto,
+			CurrencyCode: core.CurrencyCode(code),
+		}
+		if classic {
+			currency.CurrencyType = core.CurrencyTypeClassic
+			currency.BankCode = core.CurrencyBank(bank)
+		}
+		converterPair.Currencies = append(converterPair.Currencies, currency)
+	}
+	return converterPair, nil
+}
+
+func formatConverterPair(converterPair core.ConverterPair) string {
+	currencies := make([]string, 0, len(converterPair.Currencies))
+	for _, currency := range converterPair.Currencies {
+		if currency.CurrencyType == core.CurrencyTypeClassic {
+			currencies = append(currencies,
+				fmt.Sprintf("%s:%s", currency.CurrencyCode, currency.BankCode))
+			continue
+		}
+		currencies = append(currencies, string(currency.CurrencyCode))
+	}
+	return strings.Join(currencies, " -> ")
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
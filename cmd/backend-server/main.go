@@ -2,20 +2,26 @@ package main
 
 import (
 	"context"
-	"github.com/binance-converter/backend/internal/service"
-	userDbPostgres "github.com/binance-converter/backend/internal/storage/user_db/postgres"
-	"github.com/binance-converter/backend/internal/transport/grpc"
-	"github.com/binance-converter/backend/internal/transport/grpc/handler"
-	"github.com/binance-converter/backend/pkg/binance_api"
+	"fmt"
 	"github.com/golobby/config/v3"
 	"github.com/golobby/config/v3/pkg/feeder"
 	"github.com/sirupsen/logrus"
+	"os"
 )
 
 type appConfig struct {
 	Grpc struct {
 		Port *int
 	}
+	UserDb struct {
+		// Driver is postgres by default, sqlite keeps data in a single file for deployments
+		// without a database server, memory keeps data until the process exits and is meant for
+		// local development
+		Driver string
+	}
+	SqliteUserDb struct {
+		Path string
+	}
 	PostgresUserDb struct {
 		Host     *string
 		Port     *int
@@ -23,56 +29,113 @@ type appConfig struct {
 		Password string `env:"POSTGRES_USER_DB_PASSWORD"`
 		DBName   *string
 	}
-}
-
-func main() {
-	setupLogs()
-
-	cfg, err := initConfig()
-	if err != nil {
-		logrus.Fatal(err)
+	Migrations struct {
+		// SkipOnStartup disables applying of pending migrations when the server starts
+		SkipOnStartup bool
+		// BaselineVersion is the latest version applied by hand before versions were recorded,
+		// migrations up to it are recorded as applied without running them. It is required for
+		// databases migrated by hand, migrations are not applied over tables without versions
+		BaselineVersion int
 	}
-
-	logger := logrus.New()
-
-	ctx := context.Background()
-
-	userDbConfig := userDbPostgres.Config{
-		Host:     *cfg.PostgresUserDb.Host,
-		Port:     *cfg.PostgresUserDb.Port,
-		Username: cfg.PostgresUserDb.Username,
-		Password: cfg.PostgresUserDb.Password,
-		DBName:   *cfg.PostgresUserDb.DBName,
+	ThresholdWatcher struct {
+		IntervalSeconds int
+		Hysteresis      float64
+		CooldownSeconds int
 	}
-
-	postgresDb, err := userDbPostgres.NewPostgresDB(ctx, userDbConfig)
-	if err != nil {
-		logrus.WithFields(logrus.Fields{
-			"error": err,
-		}).Fatal("error connect to postgres database")
+	ExchangeRateCollector struct {
+		IntervalSeconds int
+	}
+	QuoteCache struct {
+		TTLSeconds      int
+		MaxStaleSeconds int
+		// PairTTLSeconds overrides TTLSeconds for converter pairs, keys are currencies of the pair
+		// separated by spaces as in arguments of commands, e.g. "USDT RUB:TinkoffNew"
+		PairTTLSeconds map[string]int
+	}
+	BinanceApi struct {
+		RequestsPerSecond float64
+		Burst             int
+		Attempts          int
+		BaseDelayMillis   int
+		MaxDelayMillis    int
+		TimeoutSeconds    int
+		DepthPages        int
+	}
+	Arbitrage struct {
+		IntervalSeconds  int
+		MaxLegs          int
+		MaxCycles        int
+		MinProfitPercent float64
+		CooldownSeconds  int
 	}
+	Fees struct {
+		// TTLSeconds is a time while fee schedules are cached
+		TTLSeconds int
+	}
+	HttpProvider struct {
+		// Url of the local http provider, the provider is disabled when it is empty
+		Url            string
+		TimeoutSeconds int
+	}
+}
 
-	transaction := userDbPostgres.NewTransaction(postgresDb)
+const usage = `usage: backend-server [command]
 
-	userDb := userDbPostgres.NewUserDB(postgresDb, transaction)
+commands:
+  serve                         serve the grpc api, it is the default command
+  migrate up                    apply pending migrations
+  migrate down [steps]          roll back steps latest migrations, one by default
+  migrate version               print the latest applied migration
+  seed                          add default currencies and converter pairs
+  users list                    print registered users
+  users set-admin <id> <bool>   grant or revoke the admin role of the user
+  pairs list                    print converter pairs including disabled ones
+  pairs add <currency>...       add the converter pair after it is quoted
+  quote [flags] <currency>...   quote the converter pair
 
-	bApi := binance_api.NewBinanceApi()
+currencies are CODE for crypto currencies and CODE:BANK for classic ones, e.g. RUB:TinkoffNew USDT
+`
 
-	authService := service.NewAuth(userDb)
-	converterService := service.NewConverter(bApi, userDb)
-	currencyService := service.NewCurrency(userDb)
+func main() {
+	setupLogs()
 
-	auth := handler.NewAuthHandler(authService)
-	converter := handler.NewConverterHandler(converterService)
-	currencies := handler.NewCurrenciesHandler(currencyService)
-	exchangePlot := handler.NewExchangePlotHandler(nil)
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
 
-	grpcServer := grpc.NewServer(logger, auth, converter, currencies, exchangePlot, authService)
+	switch command {
+	case "serve", "migrate", "seed", "users", "pairs", "quote":
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
 
-	err = grpcServer.ListenAndServe(*cfg.Grpc.Port)
+	cfg, err := initConfig()
 	if err != nil {
 		logrus.Fatal(err)
 	}
+
+	ctx := context.Background()
+	a := newApp(ctx, cfg)
+
+	switch command {
+	case "serve":
+		runServe(ctx, a)
+	case "migrate":
+		runMigrate(ctx, a, args)
+	case "seed":
+		runSeed(ctx, a)
+	case "users":
+		runUsers(ctx, a, args)
+	case "pairs":
+		runPairs(ctx, a, args)
+	case "quote":
+		runQuote(ctx, a, args)
+	}
 }
 
 func setupLogs() {
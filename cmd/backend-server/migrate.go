@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"strconv"
+)
+
+// runMigrate runs `migrate up`, `migrate down [steps]` or `migrate version` command
+func runMigrate(ctx context.Context, a *app, args []string) {
+	if len(args) == 0 {
+		logrus.Fatal("usage: migrate up | down [steps] | version")
+	}
+	migrator := a.migrator
+	if migrator == nil {
+		logrus.WithFields(logrus.Fields{
+			"driver": a.cfg.UserDb.Driver,
+		}).Fatal("migrations are supported only by postgres and sqlite user dbs")
+	}
+
+	switch args[0] {
+	case "up":
+		migrateUp(ctx, migrator, a.cfg.Migrations.BaselineVersion)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			var err error
+			steps, err = strconv.Atoi(args[1])
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"steps": args[1],
+				}).Fatal("invalid number of migrations to roll back")
+			}
+		}
+		versions, err := migrator.Down(ctx, steps)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err,
+			}).Fatal("error roll back migrations")
+		}
+		logrus.WithFields(logrus.Fields{
+			"versions": versions,
+		}).Info("migrations rolled back")
+	case "version":
+		version, err := migrator.Version(ctx)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err,
+			}).Fatal("error get migrations version")
+		}
+		fmt.Println(version)
+	default:
+		logrus.WithFields(logrus.Fields{
+			"command": args[0],
+		}).Fatal("unknown migrate command")
+	}
+}
+
+func migrateUp(ctx context.Context, migrator appMigrator, baseline int) {
+	if err := migrator.Baseline(ctx, baseline); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"baseline": baseline,
+			"error":    err,
+		}).Fatal("error record migrations baseline")
+	}
+	versions, err := migrator.Up(ctx)
+	if errors.Is(err, core.ErrorMigrationUnversionedSchema) {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("database was migrated by hand, set Migrations.BaselineVersion to its version")
+	}
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("error apply migrations")
+	}
+	logrus.WithFields(logrus.Fields{
+		"versions": versions,
+	}).Info("migrations applied")
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"github.com/binance-converter/backend/internal/service"
+	"github.com/binance-converter/backend/internal/transport/grpc"
+	"github.com/binance-converter/backend/internal/transport/grpc/handler"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// runServe applies pending migrations of postgres and sqlite storages, starts background jobs and
+// serves the grpc api
+func runServe(ctx context.Context, a *app) {
+	cfg := a.cfg
+	if a.migrator != nil && !cfg.Migrations.SkipOnStartup {
+		migrateUp(ctx, a.migrator, cfg.Migrations.BaselineVersion)
+	}
+
+	logger := logrus.New()
+
+	thresholdWatcher := service.NewThresholdWatcher(a.userDb, a.converter,
+		service.ThresholdWatcherConfig{
+			Interval:   time.Duration(cfg.ThresholdWatcher.IntervalSeconds) * time.Second,
+			Hysteresis: cfg.ThresholdWatcher.Hysteresis,
+			Cooldown:   time.Duration(cfg.ThresholdWatcher.CooldownSeconds) * time.Second,
+		})
+	// alerts are broadcast in process, so the watcher and the alerts stream are expected to run
+	// in a single replica
+	alertsService := service.NewAlerts(a.userDb)
+	go thresholdWatcher.Run(ctx)
+	go alertsService.Run(ctx, thresholdWatcher.Alerts())
+
+	exchangeRateCollector := service.NewExchangeRateCollector(a.userDb, a.converter,
+		time.Duration(cfg.ExchangeRateCollector.IntervalSeconds)*time.Second)
+	go exchangeRateCollector.Run(ctx)
+
+	arbitrageService := service.NewArbitrage(a.userDb, a.converter, service.ArbitrageConfig{
+		Interval:         time.Duration(cfg.Arbitrage.IntervalSeconds) * time.Second,
+		MaxLegs:          cfg.Arbitrage.MaxLegs,
+		MaxCycles:        cfg.Arbitrage.MaxCycles,
+		MinProfitPercent: cfg.Arbitrage.MinProfitPercent,
+		Cooldown:         time.Duration(cfg.Arbitrage.CooldownSeconds) * time.Second,
+	})
+	go arbitrageService.Run(ctx)
+
+	auth := handler.NewAuthHandler(a.auth)
+	converter := handler.NewConverterHandler(a.converter)
+	currencies := handler.NewCurrenciesHandler(a.currency)
+	exchangePlot := handler.NewExchangePlotHandler(a.exchangePlot)
+	alerts := handler.NewAlertsHandler(alertsService)
+	converterExt := handler.NewConverterExtHandler(a.candles, a.converter, a.routeFinder,
+		a.depth)
+	arbitrage := handler.NewArbitrageHandler(arbitrageService)
+	admin := handler.NewAdminHandler(a.fees, a.catalog)
+
+	grpcServer := grpc.NewServer(logger, auth, converter, currencies, exchangePlot, alerts,
+		converterExt, arbitrage, admin, a.auth)
+
+	err := grpcServer.ListenAndServe(*cfg.Grpc.Port)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+}
@@ -0,0 +1,8 @@
+package core
+
+import "errors"
+
+var (
+	ErrorAdminNotAuthorized    = errors.New("not authorized")
+	ErrorAdminPermissionDenied = errors.New("permission denied")
+)
@@ -0,0 +1,38 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+type UserThresholdConvertPair struct {
+	UserId    int
+	ChatId    int64
+	Threshold ThresholdConvertPair
+}
+
+type AlertDirection int32
+
+const (
+	AlertDirectionUp   AlertDirection = 0
+	AlertDirectionDown AlertDirection = 1
+)
+
+type ThresholdAlert struct {
+	Offset        int64
+	UserId        int
+	ChatId        int64
+	ConverterPair ConverterPair
+	OldExchange   Exchange
+	NewExchange   Exchange
+	Threshold     Exchange
+	Direction     AlertDirection
+	CreatedAt     time.Time
+}
+
+var (
+	ErrorAlertEmptyInputArg    = errors.New("empty input arguments")
+	ErrorAlertInvalidOffset    = errors.New("invalid alert offset")
+	ErrorAlertInvalidDirection = errors.New("invalid alert direction")
+	ErrorAlertSubscriberSlow   = errors.New("alert subscriber is too slow")
+)
@@ -0,0 +1,32 @@
+package core
+
+import (
+	"errors"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+type ArbitrageOpportunity struct {
+	Offset int64
+	// ConverterPair is a closed route, its first and last currencies are equal
+	ConverterPair ConverterPair
+	// Rate is amount of the first currency received for one of it after the cycle before fees
+	Rate decimal.Decimal
+	// NetRate is Rate after fees and spreads of all legs
+	NetRate    decimal.Decimal
+	DetectedAt time.Time
+}
+
+type ArbitrageSubscriber struct {
+	UserId int
+	ChatId int64
+}
+
+var (
+	ErrorArbitrageEmptyInputArg = errors.New("empty input arguments")
+	ErrorArbitrageNotAuthorized = errors.New("not authorized")
+	ErrorArbitrageInvalidOffset = errors.New("invalid arbitrage opportunity offset")
+	ErrorArbitrageInvalidLimit  = errors.New("invalid arbitrage opportunities limit")
+	ErrorArbitrageInvalidCycle  = errors.New("invalid arbitrage cycle")
+	ErrorArbitrageUserNotFound  = errors.New("user not found")
+)
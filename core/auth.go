@@ -18,6 +18,17 @@ type ServiceSignUpUserByTelegramData struct {
 	LanguageCode string
 }
 
+// User is a registered user, optional fields are nil when telegram did not provide them
+type User struct {
+	Id           int
+	ChatId       *int64
+	UserName     *string
+	FirstName    string
+	LastName     string
+	LanguageCode *string
+	IsAdmin      bool
+}
+
 var (
 	ErrorAuthServiceEmptyInputArg         = errors.New("empty input arguments")
 	ErrorAuthServiceAuthUserAlreadyExists = errors.New("error user already exists")
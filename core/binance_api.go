@@ -4,4 +4,11 @@ import "errors"
 
 var (
 	ErrorBinanceApiInvalidConverterPair = errors.New("invalid converter pair")
+	ErrorBinanceApiInvalidAmount        = errors.New("invalid amount")
+	ErrorBinanceApiInvalidTradeSide     = errors.New("invalid trade side")
+	ErrorBinanceApiInvalidLimit         = errors.New("invalid limit")
+	ErrorBinanceApiNoOffers             = errors.New("no offers for converter pair")
+	ErrorBinanceApiRateLimited          = errors.New("upstream rate limited")
+	ErrorBinanceApiUnavailable          = errors.New("upstream unavailable")
+	ErrorBinanceApiBadResponse          = errors.New("bad upstream response")
 )
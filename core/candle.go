@@ -0,0 +1,53 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+type CandleTimeframe int32
+
+const (
+	CandleTimeframeOneMinute   CandleTimeframe = 0
+	CandleTimeframeFiveMinutes CandleTimeframe = 1
+	CandleTimeframeOneHour     CandleTimeframe = 2
+	CandleTimeframeOneDay      CandleTimeframe = 3
+)
+
+func (c CandleTimeframe) Duration() (time.Duration, error) {
+	switch c {
+	case CandleTimeframeOneMinute:
+		return time.Minute, nil
+	case CandleTimeframeFiveMinutes:
+		return 5 * time.Minute, nil
+	case CandleTimeframeOneHour:
+		return time.Hour, nil
+	case CandleTimeframeOneDay:
+		return 24 * time.Hour, nil
+	}
+	return 0, ErrorCandleInvalidTimeframe
+}
+
+type CandleParams struct {
+	ConverterPair ConverterPair
+	Timeframe     CandleTimeframe
+	TimeInterval  TimeInterval
+}
+
+type Candle struct {
+	OpenTime    time.Time
+	Open        Exchange
+	High        Exchange
+	Low         Exchange
+	Close       Exchange
+	SampleCount int
+}
+
+var (
+	ErrorCandleEmptyInputArg             = errors.New("empty input arguments")
+	ErrorCandleInvalidConverterPair      = errors.New("invalid converter pair")
+	ErrorCandleInvalidTimeInterval       = errors.New("invalid time interval")
+	ErrorCandleInvalidTimeframe          = errors.New("invalid candle timeframe")
+	ErrorCandleTooManyCandles            = errors.New("too many candles for time interval")
+	ErrorCandleConverterPairNotSupported = errors.New("converter pair not supported")
+)
@@ -0,0 +1,20 @@
+package core
+
+import "errors"
+
+// CatalogCurrency is a currency known to the service, disabled currencies are hidden from users
+type CatalogCurrency struct {
+	Currency FullCurrency
+	Disabled bool
+}
+
+// CatalogConverterPair is a converter pair known to the service, disabled pairs are hidden from
+// users
+type CatalogConverterPair struct {
+	ConverterPair ConverterPair
+	Disabled      bool
+}
+
+var (
+	ErrorCatalogPairNotQuotable = errors.New("converter pair is not quotable")
+)
@@ -1,12 +1,51 @@
 package core
 
-import "errors"
+import (
+	"errors"
+	"github.com/shopspring/decimal"
+)
+
+type TradeSide int32
+
+const (
+	TradeSideAuto TradeSide = 0
+	TradeSideBuy  TradeSide = 1
+	TradeSideSell TradeSide = 2
+)
 
 type ConverterPair struct {
 	Currencies []FullCurrency
+	// Amount of the first currency to convert, default amount is used when it is zero
+	Amount decimal.Decimal
+	// TradeSide forces side of the p2p trade, by default it depends on order of currencies
+	TradeSide TradeSide
+	// Providers to quote from, all registered providers are used when it is empty. They are chosen
+	// per request and not stored with subscriptions, so background jobs quote all providers
+	Providers []ProviderName
+	// AdvertiserFilter selects offers the exchange is taken from, nil means that preferences of the
+	// user are not resolved yet
+	AdvertiserFilter *AdvertiserFilter
+}
+
+// Legs returns converter pairs of two adjacent currencies of the route of converter pair
+func (c ConverterPair) Legs() []ConverterPair {
+	if len(c.Currencies) < 2 {
+		return nil
+	}
+	legs := make([]ConverterPair, 0, len(c.Currencies)-1)
+	for i := 0; i+1 < len(c.Currencies); i++ {
+		legs = append(legs, ConverterPair{
+			Currencies:       c.Currencies[i : i+2],
+			Providers:        c.Providers,
+			AdvertiserFilter: c.AdvertiserFilter,
+		})
+	}
+	return legs
 }
 
-type Exchange float32
+// Exchange is an exact decimal exchange rate, float values are only used at the boundaries of the
+// service
+type Exchange = decimal.Decimal
 
 type ThresholdConvertPair struct {
 	ConverterPair ConverterPair
@@ -19,4 +58,12 @@ var (
 	ErrorConverterNotAuthorized              = errors.New("not authorized")
 	ErrorConverterConverterPairAlreadyExists = errors.New("converter pair already exists")
 	ErrorConverterConverterPairNotFound      = errors.New("converter pair not found")
+	ErrorConverterConverterPairNotSubscribed = errors.New("converter pair not subscribed")
+	ErrorConverterConverterPairDisabled      = errors.New("converter pair is disabled")
+	ErrorConverterInvalidThreshold           = errors.New("invalid threshold")
+	ErrorConverterInvalidAmount              = errors.New("invalid amount")
+	ErrorConverterInvalidTradeSide           = errors.New("invalid trade side")
+	ErrorConverterInvalidExchange            = errors.New("invalid exchange")
+	ErrorConverterAmountTooSmall             = errors.New("amount is too small to cover fees")
+	ErrorConverterTargetNotReachable         = errors.New("target amount can not be reached")
 )
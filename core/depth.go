@@ -0,0 +1,32 @@
+package core
+
+import (
+	"errors"
+	"github.com/shopspring/decimal"
+)
+
+type DepthFill struct {
+	Offer Offer
+	// AmountIn is amount of the first currency of the pair sent to the offer and AmountOut is
+	// amount of the second one received from it
+	AmountIn  decimal.Decimal
+	AmountOut decimal.Decimal
+}
+
+type DepthQuote struct {
+	ConverterPair ConverterPair
+	// Exchange is volume weighted exchange of consumed offers and BestExchange is exchange of the
+	// best offer of the order book, both are quoted as classic currency per crypto currency
+	Exchange     Exchange
+	BestExchange Exchange
+	// SlippagePercent is how much Exchange is worse than BestExchange for the user
+	SlippagePercent decimal.Decimal
+	AmountIn        decimal.Decimal
+	AmountOut       decimal.Decimal
+	Fills           []DepthFill
+}
+
+var (
+	ErrorDepthInvalidAmount         = errors.New("invalid amount")
+	ErrorDepthInsufficientLiquidity = errors.New("not enough offers to fill amount")
+)
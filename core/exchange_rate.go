@@ -0,0 +1,12 @@
+package core
+
+import "time"
+
+type ExchangeRate struct {
+	ConverterPair ConverterPair
+	Exchange      Exchange
+	SampledAt     time.Time
+	// Source is the provider which quoted the rate, providers of legs are separated by commas when
+	// legs of the route are quoted by different providers
+	Source string
+}
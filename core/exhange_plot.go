@@ -4,10 +4,21 @@ import (
 	"errors"
 	timeInterval "github.com/go-follow/time-interval"
 	"image"
+	"time"
 )
 
 type TimeInterval timeInterval.Span
 
+func (t TimeInterval) Start() time.Time {
+	span := timeInterval.Span(t)
+	return span.Start()
+}
+
+func (t TimeInterval) End() time.Time {
+	span := timeInterval.Span(t)
+	return span.End()
+}
+
 type PlotParams struct {
 	ConverterPair ConverterPair
 	TimeInterval  TimeInterval
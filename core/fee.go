@@ -0,0 +1,41 @@
+package core
+
+import (
+	"errors"
+	"github.com/shopspring/decimal"
+)
+
+type FeeScope int32
+
+const (
+	FeeScopeBank     FeeScope = 0
+	FeeScopeProvider FeeScope = 1
+)
+
+type FeeSchedule struct {
+	Scope FeeScope
+	// Key is a bank code for bank fees and a provider name for provider fees
+	Key string
+	// Fixed fee is charged in classic currency of a leg
+	Fixed decimal.Decimal
+	// Percent fee is charged from amount received on a leg
+	Percent decimal.Decimal
+	// Spread is a percent the exchange of a leg is worsened by for the side of the trade, it is
+	// added to the exchange when crypto currency is bought and subtracted when it is sold
+	Spread decimal.Decimal
+}
+
+type AppliedFee struct {
+	Scope FeeScope
+	Key   string
+	// Amount of the fee with the spread in currency received on the leg
+	Amount decimal.Decimal
+}
+
+var (
+	ErrorFeeEmptyInputArg = errors.New("empty input arguments")
+	ErrorFeeInvalidScope  = errors.New("invalid fee scope")
+	ErrorFeeInvalidKey    = errors.New("invalid fee key")
+	ErrorFeeInvalidFee    = errors.New("invalid fee")
+	ErrorFeeNotFound      = errors.New("fee schedule not found")
+)
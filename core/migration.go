@@ -0,0 +1,24 @@
+package core
+
+import "errors"
+
+// Migration is a versioned change of the database schema with its rollback
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	// Down is empty when the migration can not be rolled back
+	Down string
+}
+
+var (
+	ErrorMigrationInvalidName      = errors.New("invalid migration file name")
+	ErrorMigrationDuplicateVersion = errors.New("duplicate migration version")
+	ErrorMigrationNotFound         = errors.New("applied migration not found")
+	ErrorMigrationIrreversible     = errors.New("migration has no down file")
+	ErrorMigrationInvalidSteps     = errors.New("invalid number of migrations to roll back")
+	ErrorMigrationInvalidBaseline  = errors.New("invalid baseline version")
+	// ErrorMigrationUnversionedSchema is returned when tables exist but no version is recorded, the
+	// schema was migrated by hand and its version must be set as the baseline
+	ErrorMigrationUnversionedSchema = errors.New("schema exists without recorded migrations")
+)
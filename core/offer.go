@@ -0,0 +1,73 @@
+package core
+
+import (
+	"errors"
+	"github.com/shopspring/decimal"
+)
+
+type Advertiser struct {
+	UserNo          string
+	NickName        string
+	IsMerchant      bool
+	MonthOrderCount int
+	// CompletionRate is a share of completed orders for the last month in [0, 1]
+	CompletionRate float64
+}
+
+type Offer struct {
+	AdvNo      string
+	Advertiser Advertiser
+	Exchange   Exchange
+	// MinAmount and MaxAmount are limits of a single order in classic currency
+	MinAmount decimal.Decimal
+	MaxAmount decimal.Decimal
+	// AvailableQuantity is a quantity of crypto currency left in the offer
+	AvailableQuantity decimal.Decimal
+	PayMethods        []string
+	// Provider is a venue of the offer
+	Provider ProviderName
+}
+
+var (
+	ErrorOfferInvalidLimit = errors.New("invalid offers limit")
+)
+
+// AdvertiserFilter selects offers of advertisers the user is willing to trade with, zero filter
+// selects all offers
+type AdvertiserFilter struct {
+	MerchantOnly bool
+	// MinCompletionRate is a minimal share of completed orders for the last month in [0, 1]
+	MinCompletionRate float64
+	MinOrderCount     int
+	// ExcludedAdvertisers are user numbers of advertisers whose offers are skipped
+	ExcludedAdvertisers []string
+}
+
+// IsEmpty reports whether the filter selects all offers
+func (f AdvertiserFilter) IsEmpty() bool {
+	return !f.MerchantOnly && f.MinCompletionRate == 0 && f.MinOrderCount == 0 &&
+		len(f.ExcludedAdvertisers) == 0
+}
+
+// Match reports whether offers of the advertiser are selected by the filter
+func (f AdvertiserFilter) Match(advertiser Advertiser) bool {
+	if f.MerchantOnly && !advertiser.IsMerchant {
+		return false
+	}
+	if advertiser.CompletionRate < f.MinCompletionRate ||
+		advertiser.MonthOrderCount < f.MinOrderCount {
+		return false
+	}
+	for _, userNo := range f.ExcludedAdvertisers {
+		if userNo == advertiser.UserNo {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	ErrorAdvertiserFilterInvalidCompletionRate = errors.New("invalid min completion rate")
+	ErrorAdvertiserFilterInvalidOrderCount     = errors.New("invalid min order count")
+	ErrorAdvertiserFilterInvalidAdvertiser     = errors.New("invalid excluded advertiser")
+)
@@ -0,0 +1,25 @@
+package core
+
+import "errors"
+
+type ProviderName string
+
+const (
+	ProviderBinanceP2P ProviderName = "binance_p2p"
+	ProviderLocalHttp  ProviderName = "local_http"
+)
+
+// ProviderQuote is an exchange with the provider which quoted it
+type ProviderQuote struct {
+	Provider ProviderName
+	Exchange Exchange
+}
+
+var (
+	ErrorProviderNotFound      = errors.New("provider not found")
+	ErrorProviderNoProviders   = errors.New("no providers")
+	ErrorProviderNoOffers      = errors.New("no offers")
+	ErrorProviderUnavailable   = errors.New("provider unavailable")
+	ErrorProviderBadResponse   = errors.New("bad response of provider")
+	ErrorProviderAlreadyExists = errors.New("provider already exists")
+)
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"errors"
+	"github.com/shopspring/decimal"
+)
+
+type RouteLeg struct {
+	ConverterPair ConverterPair
+	Exchange      Exchange
+	// Rate is amount of the second currency of the leg received for one of the first one
+	Rate decimal.Decimal
+	// AmountIn and AmountOut are amounts of the first and the second currencies of the leg, they
+	// are zero when amount of the route is not set
+	AmountIn  decimal.Decimal
+	AmountOut decimal.Decimal
+	// Provider quoted the leg and Fees are charged on the leg, they are already applied to Rate and
+	// AmountOut
+	Provider ProviderName
+	Fees     []AppliedFee
+}
+
+type RouteQuote struct {
+	ConverterPair ConverterPair
+	// Exchange is quoted the same way as exchange of converter pair
+	Exchange Exchange
+	// Rate is effective amount of the last currency received for one of the first one
+	Rate decimal.Decimal
+	Legs []RouteLeg
+	// Spot is set when amount of the route is not set, fixed fees depend on amount, so they are
+	// not charged on spot quotes and Rate is a rate of small amounts
+	Spot bool
+}
+
+type RouteParams struct {
+	Source FullCurrency
+	Target FullCurrency
+	// Amount of the source currency, default amount is used when it is zero
+	Amount decimal.Decimal
+	// MaxLegs limits length of routes, default is used when it is zero
+	MaxLegs int
+	// Limit is a maximum number of returned routes, default is used when it is zero
+	Limit     int
+	Providers []ProviderName
+}
+
+var (
+	ErrorRouteEmptyInputArg  = errors.New("empty input arguments")
+	ErrorRouteInvalidTarget  = errors.New("target currency is equal to source one")
+	ErrorRouteInvalidMaxLegs = errors.New("invalid max legs of route")
+	ErrorRouteInvalidLimit   = errors.New("invalid routes limit")
+	ErrorRouteNotFound       = errors.New("route not found")
+)
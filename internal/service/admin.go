@@ -0,0 +1,31 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+type AdminUserDb interface {
+	IsAdmin(ctx context.Context, userId int) (bool, error)
+}
+
+// requireAdmin returns an error unless the user of ctx has the admin role
+func requireAdmin(ctx context.Context, userDb AdminUserDb) error {
+	userId, err := core.ContextGetUserId(ctx)
+	if err != nil {
+		return core.ErrorAdminNotAuthorized
+	}
+	isAdmin, err := userDb.IsAdmin(ctx, userId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"userId": userId,
+			"error":  err.Error(),
+		}).Error("error check admin role of user")
+		return err
+	}
+	if !isAdmin {
+		return core.ErrorAdminPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+const maxExcludedAdvertisers = 100
+
+type AdvertiserFilterUserDb interface {
+	GetUserAdvertiserFilter(ctx context.Context, userId int) (core.AdvertiserFilter, error)
+}
+
+// resolveAdvertiserFilter sets advertiser filter of the user of ctx to the converter pair unless it
+// is already resolved, requests without a user are not filtered
+func resolveAdvertiserFilter(ctx context.Context, userDb AdvertiserFilterUserDb,
+	converterPair core.ConverterPair) (core.ConverterPair, error) {
+	if converterPair.AdvertiserFilter != nil {
+		return converterPair, nil
+	}
+
+	var filter core.AdvertiserFilter
+	if userId, err := core.ContextGetUserId(ctx); err == nil {
+		filter, err = userDb.GetUserAdvertiserFilter(ctx, userId)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"userId": userId,
+				"error":  err.Error(),
+			}).Error("error get advertiser filter of user")
+			return core.ConverterPair{}, err
+		}
+	}
+	converterPair.AdvertiserFilter = &filter
+	return converterPair, nil
+}
+
+func validateAdvertiserFilter(filter core.AdvertiserFilter) error {
+	if filter.MinCompletionRate < 0 || filter.MinCompletionRate > 1 {
+		return core.ErrorAdvertiserFilterInvalidCompletionRate
+	}
+	if filter.MinOrderCount < 0 {
+		return core.ErrorAdvertiserFilterInvalidOrderCount
+	}
+	if len(filter.ExcludedAdvertisers) > maxExcludedAdvertisers {
+		return core.ErrorAdvertiserFilterInvalidAdvertiser
+	}
+	for _, userNo := range filter.ExcludedAdvertisers {
+		if userNo == "" || len(userNo) > 255 {
+			return core.ErrorAdvertiserFilterInvalidAdvertiser
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"sync"
+)
+
+const (
+	alertsReplayPageSize      = 100
+	alertsSubscriberQueueSize = 64
+)
+
+type AlertsUserDb interface {
+	AdminUserDb
+	AddThresholdAlert(ctx context.Context, alert core.ThresholdAlert) (int64, error)
+	GetThresholdAlerts(ctx context.Context, afterOffset int64, limit int) ([]core.ThresholdAlert,
+		error)
+	GetLastThresholdAlertOffset(ctx context.Context) (int64, error)
+}
+
+type alertsSubscriber struct {
+	alerts chan core.ThresholdAlert
+}
+
+type Alerts struct {
+	userDb AlertsUserDb
+
+	mu          sync.Mutex
+	subscribers map[*alertsSubscriber]struct{}
+}
+
+func NewAlerts(userDb AlertsUserDb) *Alerts {
+	return &Alerts{
+		userDb:      userDb,
+		subscribers: make(map[*alertsSubscriber]struct{}),
+	}
+}
+
+// Run persists every alert from source and delivers it to the subscribers until source is closed
+func (a *Alerts) Run(ctx context.Context, source <-chan core.ThresholdAlert) {
+	for alert := range source {
+		offset, err := a.userDb.AddThresholdAlert(ctx, alert)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"alert": alert,
+				"error": err.Error(),
+			}).Error("error save threshold alert")
+			continue
+		}
+		alert.Offset = offset
+		a.broadcast(alert)
+	}
+}
+
+// Subscribe calls send for every alert after afterOffset: at first for saved alerts and then for
+// new ones as they occur. It blocks until ctx is done or send returns an error. Alerts of all users
+// are sent, so only admins, such as the account of the bot, may subscribe.
+func (a *Alerts) Subscribe(ctx context.Context, afterOffset int64,
+	send func(alert core.ThresholdAlert) error) error {
+	if err := requireAdmin(ctx, a.userDb); err != nil {
+		return err
+	}
+	lastOffset, err := a.userDb.GetLastThresholdAlertOffset(ctx)
+	if err != nil {
+		return err
+	}
+	if afterOffset < 0 || afterOffset > lastOffset {
+		return core.ErrorAlertInvalidOffset
+	}
+
+	// subscribe before replay so alerts saved during replay are not missed
+	subscriber := a.addSubscriber()
+	defer a.removeSubscriber(subscriber)
+
+	offset := afterOffset
+	for {
+		alerts, err := a.userDb.GetThresholdAlerts(ctx, offset, alertsReplayPageSize)
+		if err != nil {
+			return err
+		}
+		for _, alert := range alerts {
+			if err := send(alert); err != nil {
+				return err
+			}
+			offset = alert.Offset
+		}
+		if len(alerts) < alertsReplayPageSize {
+			break
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case alert, ok := <-subscriber.alerts:
+			if !ok {
+				return core.ErrorAlertSubscriberSlow
+			}
+			if alert.Offset <= offset {
+				continue
+			}
+			if err := send(alert); err != nil {
+				return err
+			}
+			offset = alert.Offset
+		}
+	}
+}
+
+func (a *Alerts) broadcast(alert core.ThresholdAlert) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for subscriber := range a.subscribers {
+		select {
+		case subscriber.alerts <- alert:
+		default:
+			// subscriber resumes from its last offset after reconnect
+			close(subscriber.alerts)
+			delete(a.subscribers, subscriber)
+		}
+	}
+}
+
+func (a *Alerts) addSubscriber() *alertsSubscriber {
+	subscriber := &alertsSubscriber{
+		alerts: make(chan core.ThresholdAlert, alertsSubscriberQueueSize),
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.subscribers[subscriber] = struct{}{}
+
+	return subscriber
+}
+
+func (a *Alerts) removeSubscriber(subscriber *alertsSubscriber) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.subscribers[subscriber]; ok {
+		close(subscriber.alerts)
+		delete(a.subscribers, subscriber)
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"testing"
+	"time"
+)
+
+// fakeAlertsUserDb keeps saved alerts in memory, offset of an alert is its index plus one
+type fakeAlertsUserDb struct {
+	admins map[int]bool
+	alerts []core.ThresholdAlert
+}
+
+func (f *fakeAlertsUserDb) IsAdmin(ctx context.Context, userId int) (bool, error) {
+	return f.admins[userId], nil
+}
+
+func (f *fakeAlertsUserDb) AddThresholdAlert(ctx context.Context,
+	alert core.ThresholdAlert) (int64, error) {
+	f.alerts = append(f.alerts, alert)
+	return int64(len(f.alerts)), nil
+}
+
+func (f *fakeAlertsUserDb) GetThresholdAlerts(ctx context.Context, afterOffset int64,
+	limit int) ([]core.ThresholdAlert, error) {
+	var alerts []core.ThresholdAlert
+	for i := int(afterOffset); i < len(f.alerts) && len(alerts) < limit; i++ {
+		alert := f.alerts[i]
+		alert.Offset = int64(i + 1)
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+func (f *fakeAlertsUserDb) GetLastThresholdAlertOffset(ctx context.Context) (int64, error) {
+	return int64(len(f.alerts)), nil
+}
+
+func TestAlertsSubscribeRequiresAdmin(t *testing.T) {
+	const adminId, userId = 1, 2
+	userDb := &fakeAlertsUserDb{
+		admins: map[int]bool{adminId: true},
+		alerts: []core.ThresholdAlert{{ChatId: 1001}, {ChatId: 1002}},
+	}
+	alerts := NewAlerts(userDb)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"anonymous", context.Background(), core.ErrorAdminNotAuthorized},
+		{"not admin", core.ContextAddUserId(context.Background(), userId),
+			core.ErrorAdminPermissionDenied},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := alerts.Subscribe(test.ctx, 0, func(alert core.ThresholdAlert) error {
+				t.Fatalf("alert of chat %d is sent", alert.ChatId)
+				return nil
+			})
+			if !errors.Is(err, test.want) {
+				t.Fatalf("subscribe: got error %v, want %v", err, test.want)
+			}
+		})
+	}
+
+	t.Run("admin", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(core.ContextAddUserId(context.Background(), adminId),
+			50*time.Millisecond)
+		defer cancel()
+
+		var sent []int64
+		err := alerts.Subscribe(ctx, 0, func(alert core.ThresholdAlert) error {
+			sent = append(sent, alert.ChatId)
+			return nil
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("subscribe: got error %v, want %v", err, context.DeadlineExceeded)
+		}
+		if len(sent) != 2 || sent[0] != 1001 || sent[1] != 1002 {
+			t.Fatalf("sent alerts of chats %v, want [1001 1002]", sent)
+		}
+	})
+}
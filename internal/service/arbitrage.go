@@ -0,0 +1,224 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultArbitrageInterval  = 5 * time.Minute
+	defaultArbitrageMaxLegs   = 4
+	maxArbitrageMaxLegs       = 6
+	defaultArbitrageMaxCycles = 100
+	defaultArbitrageCooldown  = 30 * time.Minute
+	defaultArbitrageLimit     = 20
+	maxArbitrageLimit         = 100
+)
+
+type ArbitrageUserDb interface {
+	RouteFinderUserDb
+	AddArbitrageOpportunity(ctx context.Context, opportunity core.ArbitrageOpportunity) (int64,
+		error)
+	GetArbitrageOpportunities(ctx context.Context, afterOffset int64,
+		limit int) ([]core.ArbitrageOpportunity, error)
+	GetLastArbitrageOpportunityOffset(ctx context.Context) (int64, error)
+	SetArbitrageSubscriber(ctx context.Context, userId int) error
+	DeleteArbitrageSubscriber(ctx context.Context, userId int) error
+	GetArbitrageSubscribers(ctx context.Context) ([]core.ArbitrageSubscriber, error)
+}
+
+// ArbitrageConfig zero values are replaced with defaults
+type ArbitrageConfig struct {
+	Interval time.Duration
+	// MaxLegs limits length of evaluated cycles
+	MaxLegs int
+	// MaxCycles limits number of cycles evaluated per scan
+	MaxCycles int
+	// MinProfitPercent is a minimum profit of a cycle after fees and spreads of its legs to report
+	// it, cycles are quoted without amount, so fixed fees are not included
+	MinProfitPercent float64
+	// Cooldown is a minimal time between two saved opportunities of the same cycle
+	Cooldown time.Duration
+}
+
+// Arbitrage periodically evaluates closed cycles over known currencies and persists cycles which
+// are profitable after fees
+type Arbitrage struct {
+	userDb ArbitrageUserDb
+	quoter RouteFinderQuoter
+	cfg    ArbitrageConfig
+
+	mu sync.Mutex
+	// lastOpportunities are last saved opportunities by cycle key
+	lastOpportunities map[string]core.ArbitrageOpportunity
+	// now is replaced in tests
+	now func() time.Time
+}
+
+func NewArbitrage(userDb ArbitrageUserDb, quoter RouteFinderQuoter,
+	cfg ArbitrageConfig) *Arbitrage {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultArbitrageInterval
+	}
+	if cfg.MaxLegs <= 0 || cfg.MaxLegs > maxArbitrageMaxLegs {
+		cfg.MaxLegs = defaultArbitrageMaxLegs
+	}
+	if cfg.MaxCycles <= 0 {
+		cfg.MaxCycles = defaultArbitrageMaxCycles
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = defaultArbitrageCooldown
+	}
+	return &Arbitrage{
+		userDb:            userDb,
+		quoter:            quoter,
+		cfg:               cfg,
+		lastOpportunities: make(map[string]core.ArbitrageOpportunity),
+		now:               time.Now,
+	}
+}
+
+// Run scans cycles every interval until ctx is done
+func (a *Arbitrage) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := a.Scan(ctx); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("error scan arbitrage cycles")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan evaluates cycles once and returns persisted opportunities. An opportunity is not saved
+// again while its rates are unchanged or the cooldown of its cycle is not over.
+func (a *Arbitrage) Scan(ctx context.Context) ([]core.ArbitrageOpportunity, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	graph, err := buildCurrencyGraph(ctx, a.userDb)
+	if err != nil {
+		return nil, err
+	}
+
+	cycles := graph.Cycles(a.cfg.MaxLegs)
+	if len(cycles) == 0 {
+		return nil, nil
+	}
+	if len(cycles) > a.cfg.MaxCycles {
+		logrus.WithFields(logrus.Fields{
+			"cycles":    len(cycles),
+			"maxCycles": a.cfg.MaxCycles,
+		}).Warn("too many arbitrage cycles, part of them is skipped")
+		cycles = cycles[:a.cfg.MaxCycles]
+	}
+
+	quotes, err := quoteRoutes(ctx, a.quoter, cycles)
+	if err != nil {
+		return nil, err
+	}
+
+	hundred := decimal.NewFromInt(100)
+	minNetRate := decimal.NewFromInt(1).Add(
+		decimal.NewFromFloat(a.cfg.MinProfitPercent).Div(hundred))
+	activeOpportunities := make(map[string]core.ArbitrageOpportunity)
+	for _, cycle := range cycles {
+		key := converterPairKey(cycle)
+		if last, ok := a.lastOpportunities[key]; ok {
+			activeOpportunities[key] = last
+		}
+	}
+	a.lastOpportunities = activeOpportunities
+
+	var opportunities []core.ArbitrageOpportunity
+	for _, quote := range quotes {
+		// fees of legs are already applied to the rate of the quote
+		if quote.Rate.LessThanOrEqual(minNetRate) {
+			continue
+		}
+
+		rate := decimal.NewFromInt(1)
+		for _, leg := range quote.Legs {
+			rate = rate.Mul(legRate(leg.ConverterPair, leg.Exchange))
+		}
+		opportunity := core.ArbitrageOpportunity{
+			ConverterPair: quote.ConverterPair,
+			Rate:          rate,
+			NetRate:       quote.Rate,
+			DetectedAt:    a.now().UTC(),
+		}
+		key := converterPairKey(quote.ConverterPair)
+		if last, ok := a.lastOpportunities[key]; ok && a.isDuplicate(last, opportunity) {
+			continue
+		}
+		opportunity.Offset, err = a.userDb.AddArbitrageOpportunity(ctx, opportunity)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"opportunity": opportunity,
+				"error":       err.Error(),
+			}).Error("error save arbitrage opportunity")
+			continue
+		}
+		a.lastOpportunities[key] = opportunity
+		opportunities = append(opportunities, opportunity)
+	}
+
+	return opportunities, nil
+}
+
+// isDuplicate reports whether opportunity repeats the last saved one of the same cycle
+func (a *Arbitrage) isDuplicate(last, opportunity core.ArbitrageOpportunity) bool {
+	if last.Rate.Equal(opportunity.Rate) && last.NetRate.Equal(opportunity.NetRate) {
+		return true
+	}
+	return opportunity.DetectedAt.Sub(last.DetectedAt) < a.cfg.Cooldown
+}
+
+// GetOpportunities returns up to limit opportunities detected after afterOffset
+func (a *Arbitrage) GetOpportunities(ctx context.Context, afterOffset int64,
+	limit int) ([]core.ArbitrageOpportunity, error) {
+	if limit == 0 {
+		limit = defaultArbitrageLimit
+	}
+	if limit < 0 || limit > maxArbitrageLimit {
+		return nil, core.ErrorArbitrageInvalidLimit
+	}
+
+	lastOffset, err := a.userDb.GetLastArbitrageOpportunityOffset(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if afterOffset < 0 || afterOffset > lastOffset {
+		return nil, core.ErrorArbitrageInvalidOffset
+	}
+
+	return a.userDb.GetArbitrageOpportunities(ctx, afterOffset, limit)
+}
+
+// SetSubscription opts the user in or out of arbitrage opportunities broadcast
+func (a *Arbitrage) SetSubscription(ctx context.Context, enabled bool) error {
+	userId, err := core.ContextGetUserId(ctx)
+	if err != nil {
+		return core.ErrorArbitrageNotAuthorized
+	}
+
+	if enabled {
+		return a.userDb.SetArbitrageSubscriber(ctx, userId)
+	}
+	return a.userDb.DeleteArbitrageSubscriber(ctx, userId)
+}
+
+func (a *Arbitrage) GetSubscribers(ctx context.Context) ([]core.ArbitrageSubscriber, error) {
+	return a.userDb.GetArbitrageSubscribers(ctx)
+}
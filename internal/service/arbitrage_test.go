@@ -0,0 +1,94 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"testing"
+	"time"
+)
+
+type fakeArbitrageUserDb struct {
+	*fakeRouteFinderUserDb
+	opportunities []core.ArbitrageOpportunity
+}
+
+func (f *fakeArbitrageUserDb) AddArbitrageOpportunity(ctx context.Context,
+	opportunity core.ArbitrageOpportunity) (int64, error) {
+	f.opportunities = append(f.opportunities, opportunity)
+	return int64(len(f.opportunities)), nil
+}
+
+func (f *fakeArbitrageUserDb) GetArbitrageOpportunities(ctx context.Context, afterOffset int64,
+	limit int) ([]core.ArbitrageOpportunity, error) {
+	return nil, nil
+}
+
+func (f *fakeArbitrageUserDb) GetLastArbitrageOpportunityOffset(ctx context.Context) (int64,
+	error) {
+	return int64(len(f.opportunities)), nil
+}
+
+func (f *fakeArbitrageUserDb) SetArbitrageSubscriber(ctx context.Context, userId int) error {
+	return nil
+}
+
+func (f *fakeArbitrageUserDb) DeleteArbitrageSubscriber(ctx context.Context, userId int) error {
+	return nil
+}
+
+func (f *fakeArbitrageUserDb) GetArbitrageSubscribers(
+	ctx context.Context) ([]core.ArbitrageSubscriber, error) {
+	return nil, nil
+}
+
+func TestArbitrageScanDeduplicates(t *testing.T) {
+	userDb := &fakeArbitrageUserDb{fakeRouteFinderUserDb: &fakeRouteFinderUserDb{
+		converterPairs: []core.ConverterPair{testPair(testUsdt, testBtc)},
+		classicCodes:   []core.CurrencyCode{"RUB"},
+		banks: map[core.CurrencyCode][]core.CurrencyBank{
+			"RUB": {"TinkoffNew"},
+		},
+		cryptoCodes: []core.CurrencyCode{"USDT", "BTC"},
+	}}
+	quoter := &fakeRouteFinderQuoter{rates: map[string]string{}}
+	clock := newTestClock()
+	arbitrage := NewArbitrage(userDb, quoter, ArbitrageConfig{
+		MaxLegs:  3,
+		Cooldown: 30 * time.Minute,
+	})
+	arbitrage.now = clock.Now
+
+	steps := []struct {
+		name    string
+		advance time.Duration
+		rate    string
+		saved   bool
+	}{
+		{name: "first opportunity", rate: "1.05", saved: true},
+		{name: "rate changed inside cooldown", advance: 10 * time.Minute, rate: "1.06"},
+		{name: "rate changed after cooldown", advance: 30 * time.Minute, rate: "1.06",
+			saved: true},
+		{name: "same rate after cooldown", advance: time.Hour, rate: "1.06"},
+		{name: "rate changed again", advance: time.Minute, rate: "1.07", saved: true},
+		{name: "not profitable", advance: time.Hour, rate: "0.99"},
+	}
+
+	ctx := context.Background()
+	for _, step := range steps {
+		clock.Advance(step.advance)
+		quoter.rates["RUB USDT BTC RUB"] = step.rate
+
+		before := len(userDb.opportunities)
+		opportunities, err := arbitrage.Scan(ctx)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+		if saved := len(userDb.opportunities) > before; saved != step.saved {
+			t.Fatalf("%s: saved = %v, want %v", step.name, saved, step.saved)
+		}
+		if step.saved && (len(opportunities) != 1 ||
+			!opportunities[0].NetRate.Equal(testDecimal(step.rate))) {
+			t.Fatalf("%s: opportunities = %v", step.name, opportunities)
+		}
+	}
+}
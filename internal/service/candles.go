@@ -0,0 +1,62 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	maxCandles = 1000
+)
+
+type CandlesUserDb interface {
+	GetExchangeRateCandles(ctx context.Context, converterPair core.ConverterPair,
+		timeframe time.Duration, interval core.TimeInterval) ([]core.Candle, error)
+}
+
+type Candles struct {
+	userDb CandlesUserDb
+}
+
+func NewCandles(userDb CandlesUserDb) *Candles {
+	return &Candles{userDb: userDb}
+}
+
+func (c *Candles) GetCandles(ctx context.Context, params core.CandleParams) ([]core.Candle,
+	error) {
+	if len(params.ConverterPair.Currencies) < 2 {
+		return nil, core.ErrorCandleInvalidConverterPair
+	}
+
+	timeframe, err := params.Timeframe.Duration()
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := params.TimeInterval.Start(), params.TimeInterval.End()
+	if !end.After(start) {
+		return nil, core.ErrorCandleInvalidTimeInterval
+	}
+	if end.Sub(start)/timeframe > maxCandles {
+		return nil, core.ErrorCandleTooManyCandles
+	}
+
+	candles, err := c.userDb.GetExchangeRateCandles(ctx, params.ConverterPair, timeframe,
+		params.TimeInterval)
+	if err != nil {
+		switch err {
+		case core.ErrorConverterConverterPairNotFound, core.ErrorConverterInvalidConverterPair:
+			return nil, core.ErrorCandleConverterPairNotSupported
+		default:
+			logrus.WithFields(logrus.Fields{
+				"params": params,
+				"error":  err.Error(),
+			}).Error("error get exchange rate candles from database")
+			return nil, err
+		}
+	}
+
+	return candles, nil
+}
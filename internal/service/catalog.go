@@ -0,0 +1,209 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+const maxCatalogCodeLen = 255
+
+type CatalogUserDb interface {
+	AdminUserDb
+	AddCurrencyIfHasNot(ctx context.Context, currency core.FullCurrency) (int, error)
+	SetCurrencyDisabled(ctx context.Context, currency core.FullCurrency, disabled bool) error
+	DeleteCurrency(ctx context.Context, currency core.FullCurrency) error
+	GetCatalogCurrencies(ctx context.Context) ([]core.CatalogCurrency, error)
+	AddConverterPairIfHasNot(ctx context.Context, converterPair core.ConverterPair) (int, error)
+	SetConverterPairDisabled(ctx context.Context, converterPair core.ConverterPair,
+		disabled bool) error
+	DeleteConverterPair(ctx context.Context, converterPair core.ConverterPair) error
+	GetCatalogConverterPairs(ctx context.Context) ([]core.CatalogConverterPair, error)
+}
+
+type CatalogQuoter interface {
+	QuoteRoute(ctx context.Context, converterPair core.ConverterPair) (core.RouteQuote, error)
+}
+
+// Catalog manages currencies, banks and converter pairs available to users, all its methods
+// require the admin role
+type Catalog struct {
+	userDb CatalogUserDb
+	quoter CatalogQuoter
+}
+
+func NewCatalog(userDb CatalogUserDb, quoter CatalogQuoter) *Catalog {
+	return &Catalog{userDb: userDb, quoter: quoter}
+}
+
+// AddCurrency adds the currency or enables it when it already exists, bank codes are added as
+// classic currencies of the bank
+func (c *Catalog) AddCurrency(ctx context.Context, currency core.FullCurrency) error {
+	if err := requireAdmin(ctx, c.userDb); err != nil {
+		return err
+	}
+	if err := validateCatalogCurrency(currency); err != nil {
+		return err
+	}
+
+	if _, err := c.userDb.AddCurrencyIfHasNot(ctx, currency); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"currency": currency,
+			"error":    err.Error(),
+		}).Error("error add currency")
+		return err
+	}
+	return c.userDb.SetCurrencyDisabled(ctx, currency, false)
+}
+
+// SetCurrencyDisabled hides the currency and converter pairs using it from users or shows them
+// again
+func (c *Catalog) SetCurrencyDisabled(ctx context.Context, currency core.FullCurrency,
+	disabled bool) error {
+	if err := requireAdmin(ctx, c.userDb); err != nil {
+		return err
+	}
+	if err := validateCatalogCurrency(currency); err != nil {
+		return err
+	}
+	return c.userDb.SetCurrencyDisabled(ctx, currency, disabled)
+}
+
+func (c *Catalog) DeleteCurrency(ctx context.Context, currency core.FullCurrency) error {
+	if err := requireAdmin(ctx, c.userDb); err != nil {
+		return err
+	}
+	if err := validateCatalogCurrency(currency); err != nil {
+		return err
+	}
+	return c.userDb.DeleteCurrency(ctx, currency)
+}
+
+func (c *Catalog) GetCurrencies(ctx context.Context) ([]core.CatalogCurrency, error) {
+	if err := requireAdmin(ctx, c.userDb); err != nil {
+		return nil, err
+	}
+	return c.userDb.GetCatalogCurrencies(ctx)
+}
+
+// AddConverterPair adds the converter pair with its missing currencies and enables it, the pair is
+// quoted first, so users never see pairs which can not be quoted
+func (c *Catalog) AddConverterPair(ctx context.Context, converterPair core.ConverterPair) error {
+	if err := requireAdmin(ctx, c.userDb); err != nil {
+		return err
+	}
+	return c.ImportConverterPair(ctx, converterPair)
+}
+
+// ImportConverterPair adds the converter pair like AddConverterPair without the admin role, it is
+// used by operators of the server and is not exposed by the api
+func (c *Catalog) ImportConverterPair(ctx context.Context,
+	converterPair core.ConverterPair) error {
+	if err := validateCatalogConverterPair(converterPair); err != nil {
+		return err
+	}
+	if err := c.checkQuotable(ctx, converterPair); err != nil {
+		return err
+	}
+
+	if _, err := c.userDb.AddConverterPairIfHasNot(ctx, converterPair); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err.Error(),
+		}).Error("error add converter pair")
+		return err
+	}
+	return c.userDb.SetConverterPairDisabled(ctx, converterPair, false)
+}
+
+// SetConverterPairDisabled hides the converter pair from users or shows it again, the pair is
+// quoted before it is shown
+func (c *Catalog) SetConverterPairDisabled(ctx context.Context, converterPair core.ConverterPair,
+	disabled bool) error {
+	if err := requireAdmin(ctx, c.userDb); err != nil {
+		return err
+	}
+	if err := validateCatalogConverterPair(converterPair); err != nil {
+		return err
+	}
+	if !disabled {
+		if err := c.checkQuotable(ctx, converterPair); err != nil {
+			return err
+		}
+	}
+	return c.userDb.SetConverterPairDisabled(ctx, converterPair, disabled)
+}
+
+func (c *Catalog) DeleteConverterPair(ctx context.Context, converterPair core.ConverterPair) error {
+	if err := requireAdmin(ctx, c.userDb); err != nil {
+		return err
+	}
+	if err := validateCatalogConverterPair(converterPair); err != nil {
+		return err
+	}
+	return c.userDb.DeleteConverterPair(ctx, converterPair)
+}
+
+func (c *Catalog) GetConverterPairs(ctx context.Context) ([]core.CatalogConverterPair, error) {
+	if err := requireAdmin(ctx, c.userDb); err != nil {
+		return nil, err
+	}
+	return c.userDb.GetCatalogConverterPairs(ctx)
+}
+
+// checkQuotable quotes the converter pair for default amount without advertiser filter of the
+// admin, errors of unavailable upstreams are returned as is, so the admin can retry
+func (c *Catalog) checkQuotable(ctx context.Context, converterPair core.ConverterPair) error {
+	converterPair.Amount = decimal.Zero
+	converterPair.TradeSide = core.TradeSideAuto
+	converterPair.AdvertiserFilter = &core.AdvertiserFilter{}
+
+	_, err := c.quoter.QuoteRoute(ctx, converterPair)
+	switch err {
+	case nil:
+		return nil
+	case core.ErrorBinanceApiRateLimited, core.ErrorBinanceApiUnavailable,
+		core.ErrorProviderUnavailable, core.ErrorProviderNoProviders:
+		return err
+	}
+	logrus.WithFields(logrus.Fields{
+		"converterPair": converterPair,
+		"error":         err.Error(),
+	}).Error("converter pair is not quotable")
+	return core.ErrorCatalogPairNotQuotable
+}
+
+func validateCatalogCurrency(currency core.FullCurrency) error {
+	if currency.CurrencyCode == "" || len(currency.CurrencyCode) > maxCatalogCodeLen {
+		return core.ErrorCurrencyInvalidCurrencyCode
+	}
+	switch currency.CurrencyType {
+	case core.CurrencyTypeClassic:
+		if currency.BankCode == "" || len(currency.BankCode) > maxCatalogCodeLen {
+			return core.ErrorCurrencyInvalidBankCode
+		}
+	case core.CurrencyTypeCrypto:
+		if currency.BankCode != "" {
+			return core.ErrorCurrencyInvalidBankCode
+		}
+	default:
+		return core.ErrorCurrencyInvalidCurrencyType
+	}
+	return nil
+}
+
+func validateCatalogConverterPair(converterPair core.ConverterPair) error {
+	if len(converterPair.Currencies) < 2 {
+		return core.ErrorConverterInvalidConverterPair
+	}
+	for i, currency := range converterPair.Currencies {
+		if err := validateCatalogCurrency(currency); err != nil {
+			return err
+		}
+		if i > 0 && currency == converterPair.Currencies[i-1] {
+			return core.ErrorConverterInvalidConverterPair
+		}
+	}
+	return nil
+}
@@ -2,12 +2,27 @@ package service
 
 import (
 	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
 
+const (
+	defaultOffersLimit = 5
+	maxOffersLimit     = 20
+
+	maxTargetQuoteIterations = 10
+)
+
+// targetQuoteTolerance is a relative excess of target amount accepted by QuoteRouteForTarget
+var targetQuoteTolerance = decimal.New(1, -6)
+
 type ConverterBinanceApi interface {
 	GetExchange(ctx context.Context, converterPair core.ConverterPair) (core.Exchange, error)
+	GetProviderQuote(ctx context.Context, converterPair core.ConverterPair) (core.ProviderQuote,
+		error)
+	GetOffers(ctx context.Context, converterPair core.ConverterPair, limit int) ([]core.Offer,
+		error)
 }
 
 type ConverterUserDb interface {
@@ -17,16 +32,27 @@ type ConverterUserDb interface {
 	GetConverterPairs(ctx context.Context) ([]core.ConverterPair, error)
 	SetThresholdConvertPair(ctx context.Context, userId int,
 		threshold core.ThresholdConvertPair) error
+	DeleteThresholdConvertPair(ctx context.Context, userId int,
+		converterPair core.ConverterPair) error
 	GetThresholdConvertPair(ctx context.Context, userId int) ([]core.ThresholdConvertPair, error)
+	SetUserAdvertiserFilter(ctx context.Context, userId int, filter core.AdvertiserFilter) error
+	GetUserAdvertiserFilter(ctx context.Context, userId int) (core.AdvertiserFilter, error)
+}
+
+type ConverterFees interface {
+	LegFees(ctx context.Context, leg core.ConverterPair,
+		provider core.ProviderName) ([]core.FeeSchedule, error)
 }
 
 type Converter struct {
 	binanceApi ConverterBinanceApi
 	UserDb     ConverterUserDb
+	fees       ConverterFees
 }
 
-func NewConverter(binanceApi ConverterBinanceApi, userDb ConverterUserDb) *Converter {
-	return &Converter{binanceApi: binanceApi, UserDb: userDb}
+func NewConverter(binanceApi ConverterBinanceApi, userDb ConverterUserDb,
+	fees ConverterFees) *Converter {
+	return &Converter{binanceApi: binanceApi, UserDb: userDb, fees: fees}
 }
 
 func (c *Converter) GetAvailableConverterPairs(ctx context.Context) ([]core.ConverterPair, error) {
@@ -75,6 +101,14 @@ func (c *Converter) SetThresholdConvertPair(ctx context.Context,
 		return core.ErrorConverterNotAuthorized
 	}
 
+	// zero threshold removes all thresholds of the converter pair
+	switch threshold.Exchange.Sign() {
+	case -1:
+		return core.ErrorConverterInvalidThreshold
+	case 0:
+		return c.UserDb.DeleteThresholdConvertPair(ctx, userId, threshold.ConverterPair)
+	}
+
 	return c.UserDb.SetThresholdConvertPair(ctx, userId, threshold)
 }
 
@@ -92,64 +126,304 @@ func (c *Converter) GetMyThresholdsConvertPairs(ctx context.Context) ([]core.Thr
 	return thresholds, nil
 }
 
+// SetAdvertiserFilter stores advertiser filter applied to quotes of the user
+func (c *Converter) SetAdvertiserFilter(ctx context.Context, filter core.AdvertiserFilter) error {
+	userId, err := core.ContextGetUserId(ctx)
+	if err != nil {
+		return core.ErrorConverterNotAuthorized
+	}
+	if err := validateAdvertiserFilter(filter); err != nil {
+		return err
+	}
+	return c.UserDb.SetUserAdvertiserFilter(ctx, userId, filter)
+}
+
+func (c *Converter) GetAdvertiserFilter(ctx context.Context) (core.AdvertiserFilter, error) {
+	userId, err := core.ContextGetUserId(ctx)
+	if err != nil {
+		return core.AdvertiserFilter{}, core.ErrorConverterNotAuthorized
+	}
+	return c.UserDb.GetUserAdvertiserFilter(ctx, userId)
+}
+
 func (c *Converter) GetCurrentExchange(ctx context.Context,
 	converterPair core.ConverterPair) (core.Exchange, error) {
+	quote, err := c.QuoteRoute(ctx, converterPair)
+	if err != nil {
+		return core.Exchange{}, err
+	}
+	return quote.Exchange, nil
+}
 
-	var resExchange core.Exchange
+// QuoteRoute quotes the route of converter pair of any length, legs are quoted one by one and
+// amount received on a leg is converted on the next one, offers are selected by advertiser filter
+// of the user
+func (c *Converter) QuoteRoute(ctx context.Context,
+	converterPair core.ConverterPair) (core.RouteQuote, error) {
+	if converterPair.Amount.IsNegative() {
+		return core.RouteQuote{}, core.ErrorConverterInvalidAmount
+	}
+	if converterPair.TradeSide != core.TradeSideAuto &&
+		(len(converterPair.Currencies) != 2 || (converterPair.TradeSide != core.TradeSideBuy &&
+			converterPair.TradeSide != core.TradeSideSell)) {
+		return core.RouteQuote{}, core.ErrorConverterInvalidTradeSide
+	}
+	converterPair, err := resolveAdvertiserFilter(ctx, c.UserDb, converterPair)
+	if err != nil {
+		return core.RouteQuote{}, err
+	}
+
+	legs := converterPair.Legs()
+	if len(legs) == 0 {
+		return core.RouteQuote{}, core.ErrorConverterInvalidConverterPair
+	}
 
-	if len(converterPair.Currencies) == 2 {
-		exchange, err := c.binanceApi.GetExchange(ctx, converterPair)
+	amount := converterPair.Amount
+	quote := core.RouteQuote{
+		ConverterPair: converterPair,
+		Rate:          decimal.NewFromInt(1),
+		Legs:          make([]core.RouteLeg, 0, len(legs)),
+		Spot:          amount.IsZero(),
+	}
+	for i, leg := range legs {
+		if leg.Currencies[0] == leg.Currencies[1] {
+			return core.RouteQuote{}, core.ErrorConverterInvalidConverterPair
+		}
+		leg.Amount = amount
+		if len(legs) == 1 {
+			leg.TradeSide = converterPair.TradeSide
+		}
+
+		providerQuote, err := c.binanceApi.GetProviderQuote(ctx, leg)
 		if err != nil {
 			logrus.WithFields(logrus.Fields{
 				"converterPair": converterPair,
+				"leg":           i,
 				"error":         err.Error(),
 			}).Error("error get exchange")
-			return core.Exchange(0), err
-		}
-		resExchange = exchange
-	} else if len(converterPair.Currencies) == 3 {
-		firstConverterPair := core.ConverterPair{
-			Currencies: converterPair.Currencies[:2],
-		}
-		secondConverterPair := core.ConverterPair{
-			Currencies: converterPair.Currencies[1:],
+			return core.RouteQuote{}, err
 		}
-		firstExchange, err := c.binanceApi.GetExchange(ctx, firstConverterPair)
-		if err != nil {
+		exchange := providerQuote.Exchange
+		if exchange.Sign() <= 0 {
 			logrus.WithFields(logrus.Fields{
 				"converterPair": converterPair,
-				"error":         err.Error(),
-			}).Error("error get exchange")
-			return core.Exchange(0), err
+				"leg":           i,
+				"exchange":      exchange,
+			}).Error("invalid exchange of leg")
+			return core.RouteQuote{}, core.ErrorConverterInvalidExchange
 		}
-		secondExchange, err := c.binanceApi.GetExchange(ctx, secondConverterPair)
+
+		schedules, err := c.fees.LegFees(ctx, leg, providerQuote.Provider)
 		if err != nil {
 			logrus.WithFields(logrus.Fields{
 				"converterPair": converterPair,
+				"leg":           i,
 				"error":         err.Error(),
-			}).Error("error get exchange")
-			return core.Exchange(0), err
+			}).Error("error get fees of leg")
+			return core.RouteQuote{}, err
 		}
-		resExchange = firstExchange / secondExchange
+
+		rate, amountOut, fees := applyFees(leg, exchange, amount, schedules)
+		if rate.Sign() <= 0 {
+			return core.RouteQuote{}, core.ErrorConverterAmountTooSmall
+		}
+		quote.Rate = quote.Rate.Mul(rate)
+		quote.Legs = append(quote.Legs, core.RouteLeg{
+			ConverterPair: leg,
+			Exchange:      exchange,
+			Rate:          rate,
+			AmountIn:      amount,
+			AmountOut:     amountOut,
+			Provider:      providerQuote.Provider,
+			Fees:          fees,
+		})
+		amount = amountOut
+	}
+
+	// exchange is quoted as classic currency per crypto currency for two currencies and as the
+	// first currency per the last one for routes started by classic currency
+	if converterPair.Currencies[0].CurrencyType == core.CurrencyTypeCrypto {
+		quote.Exchange = quote.Rate
 	} else {
-		return 0, core.ErrorConverterInvalidConverterPair
+		quote.Exchange = decimal.NewFromInt(1).Div(quote.Rate)
+	}
+	return quote, nil
+}
+
+// QuoteRouteForTarget quotes the route of converter pair which receives at least target amount of
+// its last currency, rates depend on amount and fixed fees are not proportional to it, so source
+// amount is refined by quoting the route for several amounts
+func (c *Converter) QuoteRouteForTarget(ctx context.Context, converterPair core.ConverterPair,
+	target decimal.Decimal) (core.RouteQuote, error) {
+	if !target.IsPositive() {
+		return core.RouteQuote{}, core.ErrorConverterInvalidAmount
+	}
+
+	// filter is resolved once for all quotes of the route
+	converterPair, err := resolveAdvertiserFilter(ctx, c.UserDb, converterPair)
+	if err != nil {
+		return core.RouteQuote{}, err
+	}
+	converterPair.Amount = decimal.Zero
+	spot, err := c.QuoteRoute(ctx, converterPair)
+	if err != nil {
+		return core.RouteQuote{}, err
+	}
+
+	// amount is refined to receive the middle of accepted excess, so rounding of amounts does not
+	// leave received amount below target
+	tolerance := target.Mul(targetQuoteTolerance)
+	aim := target.Add(tolerance.Div(decimal.NewFromInt(2)))
+	amount := aim.Div(spot.Rate)
+	var best core.RouteQuote
+	var prevIn, prevOut decimal.Decimal
+	found, hasPrev := false, false
+	for i := 0; i < maxTargetQuoteIterations; i++ {
+		converterPair.Amount = amount
+		quote, err := c.QuoteRoute(ctx, converterPair)
+		if err == core.ErrorConverterAmountTooSmall {
+			amount = amount.Mul(decimal.NewFromInt(2))
+			hasPrev = false
+			continue
+		}
+		if err != nil {
+			return core.RouteQuote{}, err
+		}
+
+		in := quote.Legs[0].AmountIn
+		out := quote.Legs[len(quote.Legs)-1].AmountOut
+		if out.GreaterThanOrEqual(target) {
+			if !found || in.LessThan(best.Legs[0].AmountIn) {
+				best = quote
+				found = true
+			}
+			if out.Sub(target).LessThanOrEqual(tolerance) {
+				break
+			}
+		}
+
+		// secant step solves linear pricing with fixed fees exactly, proportional step is used
+		// when it is not possible
+		next := in.Mul(aim).Div(out)
+		if hasPrev && !out.Equal(prevOut) {
+			secant := in.Add(aim.Sub(out).Mul(in.Sub(prevIn)).Div(out.Sub(prevOut)))
+			if secant.IsPositive() {
+				next = secant
+			}
+		}
+		prevIn, prevOut, hasPrev = in, out, true
+		amount = next
+	}
+
+	if !found {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"target":        target,
+		}).Error("target amount is not reached")
+		return core.RouteQuote{}, core.ErrorConverterTargetNotReachable
+	}
+	return best, nil
+}
+
+// GetOffers returns up to limit best p2p offers for the converter pair of two currencies
+func (c *Converter) GetOffers(ctx context.Context, converterPair core.ConverterPair,
+	limit int) ([]core.Offer, error) {
+	if len(converterPair.Currencies) != 2 {
+		return nil, core.ErrorConverterInvalidConverterPair
+	}
+	if converterPair.Amount.IsNegative() {
+		return nil, core.ErrorConverterInvalidAmount
+	}
+	if limit == 0 {
+		limit = defaultOffersLimit
 	}
+	if limit < 0 || limit > maxOffersLimit {
+		return nil, core.ErrorOfferInvalidLimit
+	}
+	converterPair, err := resolveAdvertiserFilter(ctx, c.UserDb, converterPair)
+	if err != nil {
+		return nil, err
+	}
+
+	offers, err := c.binanceApi.GetOffers(ctx, converterPair, limit)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err.Error(),
+		}).Error("error get offers")
+		return nil, err
+	}
+	return offers, nil
+}
 
-	return resExchange, nil
+// legRate returns amount of the second currency of the leg received for one of the first one
+func legRate(leg core.ConverterPair, exchange core.Exchange) decimal.Decimal {
+	if leg.Currencies[0].CurrencyType == core.CurrencyTypeCrypto {
+		return exchange
+	}
+	return decimal.NewFromInt(1).Div(exchange)
 }
 
-func (c *Converter) makeSecondLevelPair(first core.ConverterPair,
-	second core.ConverterPair) (core.ConverterPair, error) {
-	if first.Currencies[1] != second.Currencies[0] {
-		return core.ConverterPair{},
-			core.ErrorConverterInvalidConverterPair
+// applyFees returns rate and received amount of the leg after fees with the charged fees. Spread
+// worsens the exchange for the side of the trade, percent fees are charged from received amount and
+// fixed ones are charged in classic currency of the leg. When amount is not set the leg is quoted
+// as spot: received amount is zero and fixed fees are not charged.
+func applyFees(leg core.ConverterPair, exchange core.Exchange, amount decimal.Decimal,
+	schedules []core.FeeSchedule) (decimal.Decimal, decimal.Decimal, []core.AppliedFee) {
+	spot := !amount.IsPositive()
+	base := amount
+	if spot {
+		base = decimal.NewFromInt(1)
 	}
+	received := convertAmount(leg, exchange, base)
 
-	return core.ConverterPair{
-		Currencies: []core.FullCurrency{
-			first.Currencies[0],
-			first.Currencies[1],
-			second.Currencies[1],
-		},
-	}, nil
+	hundred := decimal.NewFromInt(100)
+	fees := make([]core.AppliedFee, 0, len(schedules))
+	total := decimal.Zero
+	for _, schedule := range schedules {
+		spread := schedule.Spread.Div(hundred)
+		if !isBuyingCrypto(leg) {
+			spread = spread.Neg()
+		}
+		spreadExchange := exchange.Mul(decimal.NewFromInt(1).Add(spread))
+		fee := received.Sub(convertAmount(leg, spreadExchange, base))
+		fee = fee.Add(received.Mul(schedule.Percent).Div(hundred))
+		if !spot {
+			fee = fee.Add(fixedFee(leg, exchange, schedule.Fixed))
+		}
+		if fee.IsZero() {
+			continue
+		}
+		total = total.Add(fee)
+		fees = append(fees, core.AppliedFee{
+			Scope:  schedule.Scope,
+			Key:    schedule.Key,
+			Amount: fee,
+		})
+	}
+
+	received = received.Sub(total)
+	if spot {
+		return received, decimal.Zero, fees
+	}
+	return received.Div(amount), received, fees
+}
+
+// fixedFee converts fixed fee of classic currency to the second currency of the leg
+func fixedFee(leg core.ConverterPair, exchange core.Exchange,
+	fixed decimal.Decimal) decimal.Decimal {
+	if leg.Currencies[1].CurrencyType == core.CurrencyTypeCrypto {
+		return fixed.Div(exchange)
+	}
+	return fixed
+}
+
+// convertAmount returns amount of the second currency of the leg received for amount of the first
+// one, exchange is always quoted as classic currency per crypto currency
+func convertAmount(leg core.ConverterPair, exchange core.Exchange,
+	amount decimal.Decimal) decimal.Decimal {
+	if leg.Currencies[0].CurrencyType == core.CurrencyTypeCrypto {
+		return amount.Mul(exchange)
+	}
+	return amount.Div(exchange)
 }
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"golang.org/x/net/context"
+	"testing"
+)
+
+// fakeConverterBinanceApi quotes legs by exchange of the amount of the leg
+type fakeConverterBinanceApi struct {
+	exchange func(amount decimal.Decimal) core.Exchange
+	calls    int
+}
+
+func (f *fakeConverterBinanceApi) GetExchange(ctx context.Context,
+	converterPair core.ConverterPair) (core.Exchange, error) {
+	quote, err := f.GetProviderQuote(ctx, converterPair)
+	return quote.Exchange, err
+}
+
+func (f *fakeConverterBinanceApi) GetProviderQuote(ctx context.Context,
+	converterPair core.ConverterPair) (core.ProviderQuote, error) {
+	f.calls++
+	return core.ProviderQuote{
+		Provider: core.ProviderBinanceP2P,
+		Exchange: f.exchange(converterPair.Amount),
+	}, nil
+}
+
+func (f *fakeConverterBinanceApi) GetOffers(ctx context.Context, converterPair core.ConverterPair,
+	limit int) ([]core.Offer, error) {
+	return nil, nil
+}
+
+type fakeConverterFees struct {
+	schedules []core.FeeSchedule
+}
+
+func (f *fakeConverterFees) LegFees(ctx context.Context, leg core.ConverterPair,
+	provider core.ProviderName) ([]core.FeeSchedule, error) {
+	return f.schedules, nil
+}
+
+func TestApplyFees(t *testing.T) {
+	schedule := func(fixed, percent, spread string) core.FeeSchedule {
+		return core.FeeSchedule{
+			Scope:   core.FeeScopeBank,
+			Key:     "TinkoffNew",
+			Fixed:   testDecimal(fixed),
+			Percent: testDecimal(percent),
+			Spread:  testDecimal(spread),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		leg          core.ConverterPair
+		amount       string
+		schedules    []core.FeeSchedule
+		wantRate     string
+		wantReceived string
+		wantFee      string
+	}{
+		{
+			name:         "spot without fees",
+			leg:          testPair(testRubTinkoff, testUsdt),
+			amount:       "0",
+			wantRate:     "0.01",
+			wantReceived: "0",
+		},
+		{
+			name:         "spot skips fixed fee",
+			leg:          testPair(testRubTinkoff, testUsdt),
+			amount:       "0",
+			schedules:    []core.FeeSchedule{schedule("30", "1", "0")},
+			wantRate:     "0.0099",
+			wantReceived: "0",
+			wantFee:      "0.0001",
+		},
+		{
+			name:         "fixed fee is converted to received currency",
+			leg:          testPair(testRubTinkoff, testUsdt),
+			amount:       "10000",
+			schedules:    []core.FeeSchedule{schedule("30", "1", "0")},
+			wantRate:     "0.00987",
+			wantReceived: "98.7",
+			wantFee:      "1.3",
+		},
+		{
+			name:         "spread raises exchange of bought crypto",
+			leg:          testPair(testRubTinkoff, testUsdt),
+			amount:       "10000",
+			schedules:    []core.FeeSchedule{schedule("0", "0", "25")},
+			wantRate:     "0.008",
+			wantReceived: "80",
+			wantFee:      "20",
+		},
+		{
+			name:         "spread lowers exchange of sold crypto",
+			leg:          testPair(testUsdt, testRubTinkoff),
+			amount:       "10",
+			schedules:    []core.FeeSchedule{schedule("0", "0", "10")},
+			wantRate:     "90",
+			wantReceived: "900",
+			wantFee:      "100",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rate, received, fees := applyFees(test.leg, testDecimal("100"),
+				testDecimal(test.amount), test.schedules)
+			if !rate.Equal(testDecimal(test.wantRate)) {
+				t.Errorf("rate = %s, want %s", rate, test.wantRate)
+			}
+			if !received.Equal(testDecimal(test.wantReceived)) {
+				t.Errorf("received = %s, want %s", received, test.wantReceived)
+			}
+			if test.wantFee == "" {
+				if len(fees) != 0 {
+					t.Errorf("fees = %v, want none", fees)
+				}
+				return
+			}
+			if len(fees) != 1 || !fees[0].Amount.Equal(testDecimal(test.wantFee)) {
+				t.Errorf("fees = %v, want one of %s", fees, test.wantFee)
+			}
+		})
+	}
+}
+
+func TestConverterQuoteRouteForTarget(t *testing.T) {
+	constant := func(exchange string) func(decimal.Decimal) core.Exchange {
+		return func(decimal.Decimal) core.Exchange {
+			return testDecimal(exchange)
+		}
+	}
+	// tiered returns lower exchange below threshold amount and upper one starting from it
+	tiered := func(threshold, lower, upper string) func(decimal.Decimal) core.Exchange {
+		return func(amount decimal.Decimal) core.Exchange {
+			if amount.LessThan(testDecimal(threshold)) {
+				return testDecimal(lower)
+			}
+			return testDecimal(upper)
+		}
+	}
+	fixedFee := func(fixed string) []core.FeeSchedule {
+		return []core.FeeSchedule{{
+			Scope: core.FeeScopeBank,
+			Key:   "TinkoffNew",
+			Fixed: testDecimal(fixed),
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		exchange  func(decimal.Decimal) core.Exchange
+		schedules []core.FeeSchedule
+		// wantIn is checked with relative tolerance of target quote when it is set
+		wantIn string
+		// wantCalls is checked when it is set, the first call quotes spot rate
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "converges with fixed fee",
+			exchange:  constant("100"),
+			schedules: fixedFee("300"),
+			wantIn:    "10300",
+		},
+		{
+			name:      "amount too small for fixed fee is raised",
+			exchange:  constant("100"),
+			schedules: fixedFee("20000"),
+			wantIn:    "30000",
+		},
+		{
+			// received amount drops when amount reaches worse exchange
+			name:     "converges on non-monotonic quotes",
+			exchange: tiered("10000", "100", "101"),
+			wantIn:   "10100",
+		},
+		{
+			// received amount jumps over target, so it is never reached within tolerance and the
+			// smallest amount above target is returned after all iterations
+			name:      "iteration limit with target jumped over",
+			exchange:  tiered("10000", "101", "99"),
+			wantCalls: 1 + maxTargetQuoteIterations,
+		},
+		{
+			// exchange grows with amount, so received amount stays below target
+			name: "iteration limit with target not reached",
+			exchange: func(amount decimal.Decimal) core.Exchange {
+				return testDecimal("100").Add(amount.Div(testDecimal("100")))
+			},
+			wantCalls: 1 + maxTargetQuoteIterations,
+			wantErr:   core.ErrorConverterTargetNotReachable,
+		},
+	}
+
+	target := testDecimal("100")
+	tolerance := target.Mul(targetQuoteTolerance)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := &fakeConverterBinanceApi{exchange: test.exchange}
+			converter := NewConverter(api, nil, &fakeConverterFees{schedules: test.schedules})
+
+			quote, err := converter.QuoteRouteForTarget(context.Background(),
+				testPair(testRubTinkoff, testUsdt), target)
+			if test.wantCalls != 0 && api.calls != test.wantCalls {
+				t.Errorf("calls = %d, want %d", api.calls, test.wantCalls)
+			}
+			if test.wantErr != nil {
+				if err != test.wantErr {
+					t.Fatalf("error = %v, want %v", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			in := quote.Legs[0].AmountIn
+			out := quote.Legs[len(quote.Legs)-1].AmountOut
+			if out.LessThan(target) {
+				t.Errorf("received %s below target %s", out, target)
+			}
+			if test.wantIn == "" {
+				return
+			}
+			if out.Sub(target).GreaterThan(tolerance) {
+				t.Errorf("received %s above target %s with tolerance %s", out, target, tolerance)
+			}
+			wantIn := testDecimal(test.wantIn)
+			if in.Sub(wantIn).Abs().GreaterThan(wantIn.Mul(targetQuoteTolerance)) {
+				t.Errorf("amount = %s, want %s", in, wantIn)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"fmt"
+	"github.com/binance-converter/backend/core"
+	"sort"
+	"strings"
+)
+
+// CurrencyGraph models currencies as nodes and quoted pairs of two currencies as edges, p2p trade
+// works in both directions, so edges are undirected
+type CurrencyGraph struct {
+	currencies map[string]core.FullCurrency
+	// edges are kept in order of adding to make routes deterministic
+	edges map[string][]string
+}
+
+func NewCurrencyGraph(converterPairs []core.ConverterPair) *CurrencyGraph {
+	g := &CurrencyGraph{
+		currencies: make(map[string]core.FullCurrency),
+		edges:      make(map[string][]string),
+	}
+	for _, converterPair := range converterPairs {
+		for _, leg := range converterPair.Legs() {
+			g.AddEdge(leg.Currencies[0], leg.Currencies[1])
+		}
+	}
+	return g
+}
+
+func (g *CurrencyGraph) AddEdge(first, second core.FullCurrency) {
+	firstKey, secondKey := currencyKey(first), currencyKey(second)
+	if firstKey == secondKey || g.hasEdge(firstKey, secondKey) {
+		return
+	}
+	g.currencies[firstKey] = first
+	g.currencies[secondKey] = second
+	g.edges[firstKey] = append(g.edges[firstKey], secondKey)
+	g.edges[secondKey] = append(g.edges[secondKey], firstKey)
+}
+
+// HasRoute reports whether each leg of converter pair is an edge of the graph
+func (g *CurrencyGraph) HasRoute(converterPair core.ConverterPair) bool {
+	legs := converterPair.Legs()
+	if len(legs) == 0 {
+		return false
+	}
+	for _, leg := range legs {
+		if !g.hasEdge(currencyKey(leg.Currencies[0]), currencyKey(leg.Currencies[1])) {
+			return false
+		}
+	}
+	return true
+}
+
+// Routes returns all routes from one currency to other one without repeated currencies and with at
+// most maxLegs legs
+func (g *CurrencyGraph) Routes(from, to core.FullCurrency, maxLegs int) []core.ConverterPair {
+	fromKey, toKey := currencyKey(from), currencyKey(to)
+	if _, ok := g.currencies[fromKey]; !ok || fromKey == toKey || maxLegs <= 0 {
+		return nil
+	}
+
+	var routes []core.ConverterPair
+	path := []string{fromKey}
+	visited := map[string]bool{fromKey: true}
+
+	var walk func(key string)
+	walk = func(key string) {
+		for _, next := range g.edges[key] {
+			if visited[next] {
+				continue
+			}
+			path = append(path, next)
+			if next == toKey {
+				routes = append(routes, g.makeRoute(path))
+			} else if len(path)-1 < maxLegs {
+				visited[next] = true
+				walk(next)
+				visited[next] = false
+			}
+			path = path[:len(path)-1]
+		}
+	}
+	walk(fromKey)
+
+	return routes
+}
+
+// Cycles returns closed routes started and finished by the same classic currency with from 3 to
+// maxLegs legs, currencies may be visited several times but each leg is traded once in the same
+// direction, rotations of the same cycle are returned once
+func (g *CurrencyGraph) Cycles(maxLegs int) []core.ConverterPair {
+	var cycles []core.ConverterPair
+	seen := make(map[string]bool)
+
+	startKeys := make([]string, 0, len(g.currencies))
+	for key, currency := range g.currencies {
+		if currency.CurrencyType == core.CurrencyTypeClassic {
+			startKeys = append(startKeys, key)
+		}
+	}
+	sort.Strings(startKeys)
+
+	for _, startKey := range startKeys {
+		path := []string{startKey}
+		usedLegs := make(map[string]bool)
+
+		var walk func(key string)
+		walk = func(key string) {
+			for _, next := range g.edges[key] {
+				leg := key + ">" + next
+				if usedLegs[leg] {
+					continue
+				}
+				path = append(path, next)
+				legs := len(path) - 1
+				if next == startKey {
+					if legs >= 3 {
+						cycleKey := g.canonicalCycleKey(path)
+						if !seen[cycleKey] {
+							seen[cycleKey] = true
+							cycles = append(cycles, g.makeRoute(path))
+						}
+					}
+				} else if legs < maxLegs {
+					usedLegs[leg] = true
+					walk(next)
+					usedLegs[leg] = false
+				}
+				path = path[:len(path)-1]
+			}
+		}
+		walk(startKey)
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return converterPairKey(cycles[i]) < converterPairKey(cycles[j])
+	})
+	return cycles
+}
+
+// canonicalCycleKey returns the least key of rotations of a closed path started by classic
+// currencies
+func (g *CurrencyGraph) canonicalCycleKey(path []string) string {
+	nodes := path[:len(path)-1]
+	best := ""
+	for shift := range nodes {
+		if g.currencies[nodes[shift]].CurrencyType != core.CurrencyTypeClassic {
+			continue
+		}
+		rotation := make([]string, 0, len(nodes))
+		rotation = append(rotation, nodes[shift:]...)
+		rotation = append(rotation, nodes[:shift]...)
+		key := strings.Join(rotation, "/")
+		if best == "" || key < best {
+			best = key
+		}
+	}
+	return best
+}
+
+func (g *CurrencyGraph) hasEdge(firstKey, secondKey string) bool {
+	for _, key := range g.edges[firstKey] {
+		if key == secondKey {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *CurrencyGraph) makeRoute(path []string) core.ConverterPair {
+	route := core.ConverterPair{Currencies: make([]core.FullCurrency, 0, len(path))}
+	for _, key := range path {
+		route.Currencies = append(route.Currencies, g.currencies[key])
+	}
+	return route
+}
+
+func currencyKey(currency core.FullCurrency) string {
+	return fmt.Sprintf("%d:%s:%s", currency.CurrencyType, currency.CurrencyCode, currency.BankCode)
+}
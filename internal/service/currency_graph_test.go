@@ -0,0 +1,214 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var (
+	testEurWise = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeClassic,
+		CurrencyCode: "EUR",
+		BankCode:     "Wise",
+	}
+	testEth = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeCrypto,
+		CurrencyCode: "ETH",
+	}
+)
+
+// routeCodes formats routes as currency codes joined by spaces, e.g. "RUB USDT KZT"
+func routeCodes(routes []core.ConverterPair) []string {
+	result := make([]string, 0, len(routes))
+	for _, route := range routes {
+		codes := make([]string, 0, len(route.Currencies))
+		for _, currency := range route.Currencies {
+			codes = append(codes, string(currency.CurrencyCode))
+		}
+		result = append(result, strings.Join(codes, " "))
+	}
+	return result
+}
+
+// testSquareGraph is RUB - USDT - KZT - BTC - RUB
+func testSquareGraph() *CurrencyGraph {
+	return NewCurrencyGraph([]core.ConverterPair{
+		testPair(testRubTinkoff, testUsdt),
+		testPair(testUsdt, testKztKaspi),
+		testPair(testKztKaspi, testBtc),
+		testPair(testBtc, testRubTinkoff),
+	})
+}
+
+func TestCurrencyGraphRoutes(t *testing.T) {
+	graph := testSquareGraph()
+	graph.AddEdge(testUsdt, testBtc)
+	graph.AddEdge(testEurWise, testEth)
+
+	tests := []struct {
+		name    string
+		from    core.FullCurrency
+		to      core.FullCurrency
+		maxLegs int
+		want    []string
+	}{
+		{
+			name:    "no direct edge",
+			from:    testRubTinkoff,
+			to:      testKztKaspi,
+			maxLegs: 1,
+			want:    []string{},
+		},
+		{
+			name:    "two legs",
+			from:    testRubTinkoff,
+			to:      testKztKaspi,
+			maxLegs: 2,
+			want:    []string{"RUB USDT KZT", "RUB BTC KZT"},
+		},
+		{
+			name:    "three legs",
+			from:    testRubTinkoff,
+			to:      testKztKaspi,
+			maxLegs: 3,
+			want: []string{"RUB USDT KZT", "RUB USDT BTC KZT", "RUB BTC KZT",
+				"RUB BTC USDT KZT"},
+		},
+		{
+			name:    "direct edge",
+			from:    testRubTinkoff,
+			to:      testUsdt,
+			maxLegs: 1,
+			want:    []string{"RUB USDT"},
+		},
+		{
+			name:    "disconnected components",
+			from:    testRubTinkoff,
+			to:      testEurWise,
+			maxLegs: 4,
+			want:    []string{},
+		},
+		{
+			name:    "unknown source",
+			from:    core.FullCurrency{CurrencyType: core.CurrencyTypeCrypto, CurrencyCode: "TON"},
+			to:      testUsdt,
+			maxLegs: 4,
+			want:    []string{},
+		},
+		{
+			name:    "source equals target",
+			from:    testUsdt,
+			to:      testUsdt,
+			maxLegs: 4,
+			want:    []string{},
+		},
+		{
+			name:    "zero max legs",
+			from:    testRubTinkoff,
+			to:      testUsdt,
+			maxLegs: 0,
+			want:    []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := routeCodes(graph.Routes(test.from, test.to, test.maxLegs))
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("routes = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyGraphCycles(t *testing.T) {
+	// triangle with two classic currencies, every cycle is found from both of them
+	triangle := NewCurrencyGraph([]core.ConverterPair{
+		testPair(testRubTinkoff, testUsdt),
+		testPair(testUsdt, testKztKaspi),
+		testPair(testKztKaspi, testRubTinkoff),
+	})
+
+	disconnected := testSquareGraph()
+	disconnected.AddEdge(testEurWise, testEth)
+	disconnected.AddEdge(testEurWise, testEurWise)
+
+	tests := []struct {
+		name    string
+		graph   *CurrencyGraph
+		maxLegs int
+		want    []string
+	}{
+		{
+			name:    "rotations are deduplicated",
+			graph:   triangle,
+			maxLegs: 3,
+			want:    []string{"KZT USDT RUB KZT", "KZT RUB USDT KZT"},
+		},
+		{
+			name:    "too short for a cycle",
+			graph:   triangle,
+			maxLegs: 2,
+			want:    []string{},
+		},
+		{
+			name:    "cycle is longer than max legs",
+			graph:   testSquareGraph(),
+			maxLegs: 3,
+			want:    []string{},
+		},
+		{
+			name:    "cycle fits max legs",
+			graph:   testSquareGraph(),
+			maxLegs: 4,
+			want: []string{"KZT BTC RUB BTC KZT", "KZT BTC RUB USDT KZT",
+				"KZT USDT RUB BTC KZT", "KZT USDT RUB USDT KZT"},
+		},
+		{
+			name: "star graph",
+			graph: NewCurrencyGraph([]core.ConverterPair{
+				testPair(testRubTinkoff, testUsdt),
+				testPair(testRubTinkoff, testBtc),
+			}),
+			maxLegs: 4,
+			want:    []string{},
+		},
+		{
+			// a currency may be visited twice when legs are traded in opposite directions
+			name: "path graph",
+			graph: NewCurrencyGraph([]core.ConverterPair{
+				testPair(testRubTinkoff, testUsdt),
+				testPair(testUsdt, testKztKaspi),
+			}),
+			maxLegs: 4,
+			want:    []string{"KZT USDT RUB USDT KZT"},
+		},
+		{
+			name: "disconnected acyclic components",
+			graph: NewCurrencyGraph([]core.ConverterPair{
+				testPair(testRubTinkoff, testUsdt),
+				testPair(testEurWise, testEth),
+			}),
+			maxLegs: 4,
+			want:    []string{},
+		},
+		{
+			name:    "disconnected component without cycles",
+			graph:   disconnected,
+			maxLegs: 4,
+			want: []string{"KZT BTC RUB BTC KZT", "KZT BTC RUB USDT KZT",
+				"KZT USDT RUB BTC KZT", "KZT USDT RUB USDT KZT"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := routeCodes(test.graph.Cycles(test.maxLegs))
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("cycles = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+type DepthApi interface {
+	GetDepthQuote(ctx context.Context, converterPair core.ConverterPair) (core.DepthQuote, error)
+}
+
+// Depth quotes large conversions against the order book of binance p2p
+type Depth struct {
+	api    DepthApi
+	userDb AdvertiserFilterUserDb
+}
+
+func NewDepth(api DepthApi, userDb AdvertiserFilterUserDb) *Depth {
+	return &Depth{api: api, userDb: userDb}
+}
+
+func (d *Depth) GetDepthQuote(ctx context.Context,
+	converterPair core.ConverterPair) (core.DepthQuote, error) {
+	if len(converterPair.Currencies) != 2 {
+		return core.DepthQuote{}, core.ErrorConverterInvalidConverterPair
+	}
+	if !converterPair.Amount.IsPositive() {
+		return core.DepthQuote{}, core.ErrorDepthInvalidAmount
+	}
+	if !hasProvider(converterPair.Providers, core.ProviderBinanceP2P) {
+		return core.DepthQuote{}, core.ErrorProviderNotFound
+	}
+	converterPair, err := resolveAdvertiserFilter(ctx, d.userDb, converterPair)
+	if err != nil {
+		return core.DepthQuote{}, err
+	}
+
+	quote, err := d.api.GetDepthQuote(ctx, converterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err.Error(),
+		}).Error("error get depth quote")
+		return core.DepthQuote{}, err
+	}
+	return quote, nil
+}
+
+// hasProvider reports whether provider is selected, all providers are selected by empty list
+func hasProvider(providers []core.ProviderName, provider core.ProviderName) bool {
+	if len(providers) == 0 {
+		return true
+	}
+	for _, name := range providers {
+		if name == provider {
+			return true
+		}
+	}
+	return false
+}
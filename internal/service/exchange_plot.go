@@ -0,0 +1,73 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/binance-converter/backend/pkg/plot"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+const (
+	exchangePlotWidth  = 800
+	exchangePlotHeight = 480
+)
+
+type ExchangePlotUserDb interface {
+	GetExchangeRates(ctx context.Context, converterPair core.ConverterPair,
+		interval core.TimeInterval) ([]core.ExchangeRate, error)
+}
+
+type ExchangePlot struct {
+	userDb ExchangePlotUserDb
+}
+
+func NewExchangePlot(userDb ExchangePlotUserDb) *ExchangePlot {
+	return &ExchangePlot{userDb: userDb}
+}
+
+func (e *ExchangePlot) GetExchangePlot(ctx context.Context, params core.PlotParams) (core.Plot,
+	error) {
+	if len(params.ConverterPair.Currencies) < 2 {
+		return core.Plot{}, core.ErrorExchangePlotInvalidConverterPair
+	}
+	if !params.TimeInterval.End().After(params.TimeInterval.Start()) {
+		return core.Plot{}, core.ErrorExchangePlotInvalidTimeInterval
+	}
+
+	rates, err := e.userDb.GetExchangeRates(ctx, params.ConverterPair, params.TimeInterval)
+	if err != nil {
+		switch err {
+		case core.ErrorConverterConverterPairNotFound, core.ErrorConverterInvalidConverterPair:
+			return core.Plot{}, core.ErrorExchangePlotCovertPairNotSupported
+		default:
+			logrus.WithFields(logrus.Fields{
+				"params": params,
+				"error":  err.Error(),
+			}).Error("error get exchange rates from database")
+			return core.Plot{}, err
+		}
+	}
+
+	if len(rates) == 0 {
+		return core.Plot{}, core.ErrorExchangePlotNoDataForTimeInterval
+	}
+
+	points := make([]plot.Point, 0, len(rates))
+	for _, rate := range rates {
+		points = append(points, plot.Point{
+			Time:  rate.SampledAt,
+			Value: rate.Exchange.InexactFloat64(),
+		})
+	}
+
+	img, err := plot.LineChart(points, exchangePlotWidth, exchangePlotHeight)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"params": params,
+			"error":  err.Error(),
+		}).Error("error render exchange plot")
+		return core.Plot{}, err
+	}
+
+	return core.Plot(*img), nil
+}
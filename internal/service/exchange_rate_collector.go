@@ -0,0 +1,104 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"strings"
+	"time"
+)
+
+const (
+	defaultExchangeRateCollectorInterval = 5 * time.Minute
+)
+
+type ExchangeRateCollectorUserDb interface {
+	GetConverterPairs(ctx context.Context) ([]core.ConverterPair, error)
+	AddExchangeRate(ctx context.Context, rate core.ExchangeRate) (int64, error)
+}
+
+type ExchangeRateCollectorConverter interface {
+	QuoteRoute(ctx context.Context, converterPair core.ConverterPair) (core.RouteQuote, error)
+}
+
+type ExchangeRateCollector struct {
+	userDb    ExchangeRateCollectorUserDb
+	converter ExchangeRateCollectorConverter
+	interval  time.Duration
+}
+
+func NewExchangeRateCollector(userDb ExchangeRateCollectorUserDb,
+	converter ExchangeRateCollectorConverter, interval time.Duration) *ExchangeRateCollector {
+	if interval <= 0 {
+		interval = defaultExchangeRateCollectorInterval
+	}
+	return &ExchangeRateCollector{
+		userDb:    userDb,
+		converter: converter,
+		interval:  interval,
+	}
+}
+
+// Run samples exchange rates of all converter pairs every interval until ctx is done
+func (e *ExchangeRateCollector) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.Collect(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect samples exchange rates of all converter pairs once
+func (e *ExchangeRateCollector) Collect(ctx context.Context) {
+	converterPairs, err := e.userDb.GetConverterPairs(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error getting converter pairs from database")
+		return
+	}
+
+	for _, converterPair := range converterPairs {
+		quote, err := e.converter.QuoteRoute(ctx, converterPair)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"converterPair": converterPair,
+				"error":         err.Error(),
+			}).Error("error get current exchange for collector")
+			continue
+		}
+
+		_, err = e.userDb.AddExchangeRate(ctx, core.ExchangeRate{
+			ConverterPair: converterPair,
+			Exchange:      quote.Exchange,
+			SampledAt:     time.Now().UTC(),
+			Source:        quoteSource(quote),
+		})
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"converterPair": converterPair,
+				"error":         err.Error(),
+			}).Error("error save exchange rate")
+		}
+	}
+}
+
+// quoteSource returns providers of legs of the quote in order of legs without repeats
+func quoteSource(quote core.RouteQuote) string {
+	providers := make([]string, 0, len(quote.Legs))
+	seen := make(map[core.ProviderName]bool, len(quote.Legs))
+	for _, leg := range quote.Legs {
+		if seen[leg.Provider] {
+			continue
+		}
+		seen[leg.Provider] = true
+		providers = append(providers, string(leg.Provider))
+	}
+	return strings.Join(providers, ",")
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFeesTTL = time.Minute
+	maxFeeKeyLen   = 255
+)
+
+type FeesUserDb interface {
+	AdminUserDb
+	SetFeeSchedule(ctx context.Context, schedule core.FeeSchedule) error
+	DeleteFeeSchedule(ctx context.Context, scope core.FeeScope, key string) error
+	GetFeeSchedules(ctx context.Context) ([]core.FeeSchedule, error)
+}
+
+type feeKey struct {
+	scope core.FeeScope
+	key   string
+}
+
+// Fees keeps fee schedules of banks and providers, schedules are cached for ttl and reloaded after
+// each change
+type Fees struct {
+	userDb FeesUserDb
+	ttl    time.Duration
+
+	mu        sync.Mutex
+	schedules map[feeKey]core.FeeSchedule
+	loadedAt  time.Time
+}
+
+func NewFees(userDb FeesUserDb, ttl time.Duration) *Fees {
+	if ttl <= 0 {
+		ttl = defaultFeesTTL
+	}
+	return &Fees{userDb: userDb, ttl: ttl}
+}
+
+// LegFees returns schedules of banks of classic currencies of the leg and of the provider which
+// quoted it
+func (f *Fees) LegFees(ctx context.Context, leg core.ConverterPair,
+	provider core.ProviderName) ([]core.FeeSchedule, error) {
+	schedules, err := f.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var fees []core.FeeSchedule
+	for _, currency := range leg.Currencies {
+		if currency.CurrencyType != core.CurrencyTypeClassic || currency.BankCode == "" {
+			continue
+		}
+		if schedule, ok := schedules[feeKey{core.FeeScopeBank, string(currency.BankCode)}]; ok {
+			fees = append(fees, schedule)
+		}
+	}
+	if schedule, ok := schedules[feeKey{core.FeeScopeProvider, string(provider)}]; ok {
+		fees = append(fees, schedule)
+	}
+	return fees, nil
+}
+
+func (f *Fees) GetFeeSchedules(ctx context.Context) ([]core.FeeSchedule, error) {
+	if err := requireAdmin(ctx, f.userDb); err != nil {
+		return nil, err
+	}
+	return f.userDb.GetFeeSchedules(ctx)
+}
+
+func (f *Fees) SetFeeSchedule(ctx context.Context, schedule core.FeeSchedule) error {
+	if err := requireAdmin(ctx, f.userDb); err != nil {
+		return err
+	}
+	if err := validateFeeKey(schedule.Scope, schedule.Key); err != nil {
+		return err
+	}
+	hundred := decimal.NewFromInt(100)
+	if schedule.Fixed.IsNegative() || schedule.Percent.IsNegative() ||
+		schedule.Percent.GreaterThanOrEqual(hundred) || schedule.Spread.IsNegative() ||
+		schedule.Spread.GreaterThanOrEqual(hundred) {
+		return core.ErrorFeeInvalidFee
+	}
+
+	if err := f.userDb.SetFeeSchedule(ctx, schedule); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"schedule": schedule,
+			"error":    err.Error(),
+		}).Error("error set fee schedule")
+		return err
+	}
+	f.invalidate()
+	return nil
+}
+
+func (f *Fees) DeleteFeeSchedule(ctx context.Context, scope core.FeeScope, key string) error {
+	if err := requireAdmin(ctx, f.userDb); err != nil {
+		return err
+	}
+	if err := validateFeeKey(scope, key); err != nil {
+		return err
+	}
+
+	if err := f.userDb.DeleteFeeSchedule(ctx, scope, key); err != nil {
+		return err
+	}
+	f.invalidate()
+	return nil
+}
+
+func (f *Fees) load(ctx context.Context) (map[feeKey]core.FeeSchedule, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.schedules != nil && time.Since(f.loadedAt) < f.ttl {
+		return f.schedules, nil
+	}
+
+	list, err := f.userDb.GetFeeSchedules(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error load fee schedules")
+		return nil, err
+	}
+	schedules := make(map[feeKey]core.FeeSchedule, len(list))
+	for _, schedule := range list {
+		schedules[feeKey{schedule.Scope, schedule.Key}] = schedule
+	}
+	f.schedules = schedules
+	f.loadedAt = time.Now()
+	return schedules, nil
+}
+
+func (f *Fees) invalidate() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.schedules = nil
+}
+
+func validateFeeKey(scope core.FeeScope, key string) error {
+	if scope != core.FeeScopeBank && scope != core.FeeScopeProvider {
+		return core.ErrorFeeInvalidScope
+	}
+	if key == "" || len(key) > maxFeeKeyLen {
+		return core.ErrorFeeInvalidKey
+	}
+	return nil
+}
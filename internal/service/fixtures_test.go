@@ -0,0 +1,59 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"sync"
+	"time"
+)
+
+var (
+	testRubTinkoff = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeClassic,
+		CurrencyCode: "RUB",
+		BankCode:     "TinkoffNew",
+	}
+	testKztKaspi = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeClassic,
+		CurrencyCode: "KZT",
+		BankCode:     "KaspiBank",
+	}
+	testUsdt = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeCrypto,
+		CurrencyCode: "USDT",
+	}
+	testBtc = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeCrypto,
+		CurrencyCode: "BTC",
+	}
+)
+
+func testPair(currencies ...core.FullCurrency) core.ConverterPair {
+	return core.ConverterPair{Currencies: currencies}
+}
+
+func testDecimal(value string) decimal.Decimal {
+	return decimal.RequireFromString(value)
+}
+
+// testClock is a manually advanced clock
+type testClock struct {
+	mu      sync.Mutex
+	current time.Time
+}
+
+func newTestClock() *testClock {
+	return &testClock{current: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.current
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.current = c.current.Add(d)
+}
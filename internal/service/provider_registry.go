@@ -0,0 +1,223 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"sort"
+	"sync"
+)
+
+// ExchangeProvider is a p2p venue quoting converter pairs of two currencies
+type ExchangeProvider interface {
+	GetExchange(ctx context.Context, converterPair core.ConverterPair) (core.Exchange, error)
+	GetOffers(ctx context.Context, converterPair core.ConverterPair, limit int) ([]core.Offer,
+		error)
+}
+
+type providerResult struct {
+	exchange core.Exchange
+	offers   []core.Offer
+	err      error
+}
+
+// ProviderRegistry quotes converter pairs from several p2p venues and returns the best of their
+// quotes
+type ProviderRegistry struct {
+	mu        sync.RWMutex
+	names     []core.ProviderName
+	providers map[core.ProviderName]ExchangeProvider
+}
+
+func NewProviderRegistry() *ProviderRegistry {
+	return &ProviderRegistry{
+		providers: make(map[core.ProviderName]ExchangeProvider),
+	}
+}
+
+func (p *ProviderRegistry) Register(name core.ProviderName, provider ExchangeProvider) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.providers[name]; ok {
+		return core.ErrorProviderAlreadyExists
+	}
+	p.names = append(p.names, name)
+	p.providers[name] = provider
+	return nil
+}
+
+func (p *ProviderRegistry) Providers() []core.ProviderName {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return append([]core.ProviderName(nil), p.names...)
+}
+
+// GetExchange returns the best exchange of providers of the converter pair, error is returned
+// only when all providers fail
+func (p *ProviderRegistry) GetExchange(ctx context.Context,
+	converterPair core.ConverterPair) (core.Exchange, error) {
+	quote, err := p.GetProviderQuote(ctx, converterPair)
+	if err != nil {
+		return core.Exchange{}, err
+	}
+	return quote.Exchange, nil
+}
+
+// GetProviderQuote returns the best exchange of providers of the converter pair with the provider
+// which quoted it
+func (p *ProviderRegistry) GetProviderQuote(ctx context.Context,
+	converterPair core.ConverterPair) (core.ProviderQuote, error) {
+	names, results, err := p.quote(converterPair, func(provider ExchangeProvider) providerResult {
+		exchange, err := provider.GetExchange(ctx, converterPair)
+		return providerResult{exchange: exchange, err: err}
+	})
+	if err != nil {
+		return core.ProviderQuote{}, err
+	}
+
+	var best core.ProviderQuote
+	var firstErr error
+	found := false
+	for i, result := range results {
+		if result.err != nil {
+			logrus.WithFields(logrus.Fields{
+				"provider":      names[i],
+				"converterPair": converterPair,
+				"error":         result.err.Error(),
+			}).Warn("error get exchange from provider")
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			continue
+		}
+		if !found || isBetterExchange(converterPair, result.exchange, best.Exchange) {
+			best = core.ProviderQuote{Provider: names[i], Exchange: result.exchange}
+			found = true
+		}
+	}
+	if !found {
+		return core.ProviderQuote{}, firstErr
+	}
+
+	return best, nil
+}
+
+// GetOffers returns up to limit best offers merged from providers of the converter pair, error is
+// returned only when all providers fail
+func (p *ProviderRegistry) GetOffers(ctx context.Context, converterPair core.ConverterPair,
+	limit int) ([]core.Offer, error) {
+	names, results, err := p.quote(converterPair, func(provider ExchangeProvider) providerResult {
+		offers, err := provider.GetOffers(ctx, converterPair, limit)
+		return providerResult{offers: offers, err: err}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var offers []core.Offer
+	var firstErr error
+	succeeded := false
+	for i, result := range results {
+		if result.err != nil {
+			logrus.WithFields(logrus.Fields{
+				"provider":      names[i],
+				"converterPair": converterPair,
+				"error":         result.err.Error(),
+			}).Warn("error get offers from provider")
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			continue
+		}
+		succeeded = true
+		for _, offer := range result.offers {
+			offer.Provider = names[i]
+			offers = append(offers, offer)
+		}
+	}
+	if !succeeded {
+		return nil, firstErr
+	}
+
+	sort.SliceStable(offers, func(i, j int) bool {
+		return isBetterExchange(converterPair, offers[i].Exchange, offers[j].Exchange)
+	})
+	if len(offers) > limit {
+		offers = offers[:limit]
+	}
+
+	return offers, nil
+}
+
+// quote runs request concurrently for each provider of the converter pair, results are in order of
+// returned names
+func (p *ProviderRegistry) quote(converterPair core.ConverterPair,
+	request func(provider ExchangeProvider) providerResult) ([]core.ProviderName,
+	[]providerResult, error) {
+	names, providers, err := p.selectProviders(converterPair.Providers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]providerResult, len(providers))
+	var wg sync.WaitGroup
+	for i, provider := range providers {
+		wg.Add(1)
+		go func(i int, provider ExchangeProvider) {
+			defer wg.Done()
+			results[i] = request(provider)
+		}(i, provider)
+	}
+	wg.Wait()
+
+	return names, results, nil
+}
+
+func (p *ProviderRegistry) selectProviders(names []core.ProviderName) ([]core.ProviderName,
+	[]ExchangeProvider, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if len(names) == 0 {
+		names = p.names
+	}
+	if len(names) == 0 {
+		return nil, nil, core.ErrorProviderNoProviders
+	}
+
+	selected := make([]core.ProviderName, 0, len(names))
+	providers := make([]ExchangeProvider, 0, len(names))
+	for _, name := range names {
+		provider, ok := p.providers[name]
+		if !ok {
+			return nil, nil, core.ErrorProviderNotFound
+		}
+		selected = append(selected, name)
+		providers = append(providers, provider)
+	}
+
+	return selected, providers, nil
+}
+
+// isBetterExchange reports whether exchange is better than other one for the user, exchange is
+// always quoted as classic currency per crypto currency, so buyer of crypto currency prefers lower
+// exchange and seller prefers higher one
+func isBetterExchange(converterPair core.ConverterPair, exchange, other core.Exchange) bool {
+	if isBuyingCrypto(converterPair) {
+		return exchange.LessThan(other)
+	}
+	return exchange.GreaterThan(other)
+}
+
+func isBuyingCrypto(converterPair core.ConverterPair) bool {
+	switch converterPair.TradeSide {
+	case core.TradeSideBuy:
+		return true
+	case core.TradeSideSell:
+		return false
+	}
+	return len(converterPair.Currencies) > 0 &&
+		converterPair.Currencies[0].CurrencyType != core.CurrencyTypeCrypto
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"sync"
+	"testing"
+)
+
+// fakeExchangeProvider quotes every converter pair with the same exchange or fails with err
+type fakeExchangeProvider struct {
+	exchange string
+	offers   []core.Offer
+	err      error
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (f *fakeExchangeProvider) GetExchange(ctx context.Context,
+	converterPair core.ConverterPair) (core.Exchange, error) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	if f.err != nil {
+		return core.Exchange{}, f.err
+	}
+	return testDecimal(f.exchange), nil
+}
+
+func (f *fakeExchangeProvider) GetOffers(ctx context.Context, converterPair core.ConverterPair,
+	limit int) ([]core.Offer, error) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.offers, nil
+}
+
+func (f *fakeExchangeProvider) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func testProviderQuote(provider core.ProviderName, exchange string) core.ProviderQuote {
+	return core.ProviderQuote{Provider: provider, Exchange: testDecimal(exchange)}
+}
+
+func newTestProviderRegistry(t *testing.T,
+	providers map[core.ProviderName]*fakeExchangeProvider) *ProviderRegistry {
+	t.Helper()
+
+	registry := NewProviderRegistry()
+	for _, name := range []core.ProviderName{core.ProviderBinanceP2P, core.ProviderLocalHttp} {
+		provider, ok := providers[name]
+		if !ok {
+			continue
+		}
+		if err := registry.Register(name, provider); err != nil {
+			t.Fatalf("register provider %s: %v", name, err)
+		}
+	}
+	return registry
+}
+
+func TestProviderRegistryGetProviderQuote(t *testing.T) {
+	errorTestProvider := errors.New("provider failed")
+	buying := testPair(testRubTinkoff, testUsdt)
+	selling := testPair(testUsdt, testRubTinkoff)
+
+	tests := []struct {
+		name          string
+		binance       *fakeExchangeProvider
+		local         *fakeExchangeProvider
+		converterPair core.ConverterPair
+		providers     []core.ProviderName
+		want          core.ProviderQuote
+		wantErr       error
+	}{
+		{
+			name:          "buyer gets the lowest exchange",
+			binance:       &fakeExchangeProvider{exchange: "95"},
+			local:         &fakeExchangeProvider{exchange: "94.5"},
+			converterPair: buying,
+			want:          testProviderQuote(core.ProviderLocalHttp, "94.5"),
+		},
+		{
+			name:          "seller gets the highest exchange",
+			binance:       &fakeExchangeProvider{exchange: "95"},
+			local:         &fakeExchangeProvider{exchange: "94.5"},
+			converterPair: selling,
+			want:          testProviderQuote(core.ProviderBinanceP2P, "95"),
+		},
+		{
+			name:          "failed provider falls back to the other one",
+			binance:       &fakeExchangeProvider{err: errorTestProvider},
+			local:         &fakeExchangeProvider{exchange: "96"},
+			converterPair: buying,
+			want:          testProviderQuote(core.ProviderLocalHttp, "96"),
+		},
+		{
+			name:          "selected provider only",
+			binance:       &fakeExchangeProvider{exchange: "95"},
+			local:         &fakeExchangeProvider{exchange: "94.5"},
+			converterPair: buying,
+			providers:     []core.ProviderName{core.ProviderBinanceP2P},
+			want:          testProviderQuote(core.ProviderBinanceP2P, "95"),
+		},
+		{
+			name:          "all providers failed",
+			binance:       &fakeExchangeProvider{err: errorTestProvider},
+			local:         &fakeExchangeProvider{err: core.ErrorProviderUnavailable},
+			converterPair: buying,
+			wantErr:       errorTestProvider,
+		},
+		{
+			name:          "unknown provider",
+			binance:       &fakeExchangeProvider{exchange: "95"},
+			converterPair: buying,
+			providers:     []core.ProviderName{core.ProviderLocalHttp},
+			wantErr:       core.ErrorProviderNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			providers := map[core.ProviderName]*fakeExchangeProvider{
+				core.ProviderBinanceP2P: test.binance,
+			}
+			if test.local != nil {
+				providers[core.ProviderLocalHttp] = test.local
+			}
+			registry := newTestProviderRegistry(t, providers)
+
+			converterPair := test.converterPair
+			converterPair.Providers = test.providers
+			quote, err := registry.GetProviderQuote(context.Background(), converterPair)
+			if test.wantErr != nil {
+				if !errors.Is(err, test.wantErr) {
+					t.Fatalf("get quote: got error %v, want %v", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("get quote: %v", err)
+			}
+			if quote.Provider != test.want.Provider || !quote.Exchange.Equal(test.want.Exchange) {
+				t.Fatalf("quote = %s %s, want %s %s", quote.Provider, quote.Exchange,
+					test.want.Provider, test.want.Exchange)
+			}
+			if len(test.providers) > 0 && test.local != nil && test.local.Calls() != 0 {
+				t.Fatalf("not selected provider was called %d times", test.local.Calls())
+			}
+		})
+	}
+}
+
+func TestProviderRegistryNoProviders(t *testing.T) {
+	registry := NewProviderRegistry()
+
+	_, err := registry.GetExchange(context.Background(), testPair(testRubTinkoff, testUsdt))
+	if !errors.Is(err, core.ErrorProviderNoProviders) {
+		t.Fatalf("get exchange: got error %v, want %v", err, core.ErrorProviderNoProviders)
+	}
+	if err := registry.Register(core.ProviderBinanceP2P, &fakeExchangeProvider{}); err != nil {
+		t.Fatalf("register provider: %v", err)
+	}
+	err = registry.Register(core.ProviderBinanceP2P, &fakeExchangeProvider{})
+	if !errors.Is(err, core.ErrorProviderAlreadyExists) {
+		t.Fatalf("register provider twice: got error %v, want %v", err,
+			core.ErrorProviderAlreadyExists)
+	}
+}
+
+func TestProviderRegistryGetOffers(t *testing.T) {
+	registry := newTestProviderRegistry(t, map[core.ProviderName]*fakeExchangeProvider{
+		core.ProviderBinanceP2P: {offers: []core.Offer{
+			{AdvNo: "b1", Exchange: testDecimal("95")},
+			{AdvNo: "b2", Exchange: testDecimal("97")},
+		}},
+		core.ProviderLocalHttp: {offers: []core.Offer{
+			{AdvNo: "l1", Exchange: testDecimal("96")},
+		}},
+	})
+
+	offers, err := registry.GetOffers(context.Background(), testPair(testRubTinkoff, testUsdt), 2)
+	if err != nil {
+		t.Fatalf("get offers: %v", err)
+	}
+	want := []struct {
+		advNo    string
+		provider core.ProviderName
+	}{
+		{"b1", core.ProviderBinanceP2P},
+		{"l1", core.ProviderLocalHttp},
+	}
+	if len(offers) != len(want) {
+		t.Fatalf("offers = %+v, want %d offers", offers, len(want))
+	}
+	for i := range want {
+		if offers[i].AdvNo != want[i].advNo || offers[i].Provider != want[i].provider {
+			t.Fatalf("offer %d = %s of %s, want %s of %s", i, offers[i].AdvNo,
+				offers[i].Provider, want[i].advNo, want[i].provider)
+		}
+	}
+}
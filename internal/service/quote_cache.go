@@ -0,0 +1,213 @@
+package service
+
+import (
+	"fmt"
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultQuoteCacheTTL      = 30 * time.Second
+	defaultQuoteCacheMaxStale = 5 * time.Minute
+	quoteCacheSweepSize       = 1024
+	// quoteCacheFetchTimeout limits upstream request shared by callers, it does not depend on
+	// context of any of them
+	quoteCacheFetchTimeout = time.Minute
+)
+
+// QuoteCacheConfig zero values are replaced with defaults
+type QuoteCacheConfig struct {
+	// TTL is a time while a cached quote is returned without a request to upstream
+	TTL time.Duration
+	// MaxStale is a time while an expired quote is returned when upstream fails
+	MaxStale time.Duration
+	// PairTTLs overrides TTL for quotes of the converter pairs
+	PairTTLs []QuoteCachePairTTL
+}
+
+type QuoteCachePairTTL struct {
+	ConverterPair core.ConverterPair
+	TTL           time.Duration
+}
+
+type quoteCacheEntry struct {
+	value     interface{}
+	fetchedAt time.Time
+	ttl       time.Duration
+}
+
+type quoteCacheCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// QuoteCache caches quotes of ConverterBinanceApi per converter pair and coalesces concurrent
+// identical requests into one upstream request
+type QuoteCache struct {
+	api ConverterBinanceApi
+	cfg QuoteCacheConfig
+	// pairTTLs are TTLs of converter pairs by keys of converter pairs
+	pairTTLs map[string]time.Duration
+
+	mu      sync.Mutex
+	entries map[string]quoteCacheEntry
+	calls   map[string]*quoteCacheCall
+	// now is replaced in tests
+	now func() time.Time
+}
+
+func NewQuoteCache(api ConverterBinanceApi, cfg QuoteCacheConfig) *QuoteCache {
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultQuoteCacheTTL
+	}
+	if cfg.MaxStale <= 0 {
+		cfg.MaxStale = defaultQuoteCacheMaxStale
+	}
+	pairTTLs := make(map[string]time.Duration, len(cfg.PairTTLs))
+	for _, pairTTL := range cfg.PairTTLs {
+		if pairTTL.TTL > 0 {
+			pairTTLs[converterPairKey(pairTTL.ConverterPair)] = pairTTL.TTL
+		}
+	}
+	return &QuoteCache{
+		api:      api,
+		cfg:      cfg,
+		pairTTLs: pairTTLs,
+		entries:  make(map[string]quoteCacheEntry),
+		calls:    make(map[string]*quoteCacheCall),
+		now:      time.Now,
+	}
+}
+
+func (q *QuoteCache) GetExchange(ctx context.Context,
+	converterPair core.ConverterPair) (core.Exchange, error) {
+	quote, err := q.GetProviderQuote(ctx, converterPair)
+	if err != nil {
+		return core.Exchange{}, err
+	}
+	return quote.Exchange, nil
+}
+
+func (q *QuoteCache) GetProviderQuote(ctx context.Context,
+	converterPair core.ConverterPair) (core.ProviderQuote, error) {
+	key := fmt.Sprintf("exchange/%s/%s/%d/%v/%s", converterPairKey(converterPair),
+		converterPair.Amount.String(), converterPair.TradeSide, converterPair.Providers,
+		advertiserFilterKey(converterPair.AdvertiserFilter))
+
+	value, err := q.get(ctx, key, q.ttl(converterPair), func(ctx context.Context) (interface{},
+		error) {
+		return q.api.GetProviderQuote(ctx, converterPair)
+	})
+	if err != nil {
+		return core.ProviderQuote{}, err
+	}
+	return value.(core.ProviderQuote), nil
+}
+
+func (q *QuoteCache) GetOffers(ctx context.Context, converterPair core.ConverterPair,
+	limit int) ([]core.Offer, error) {
+	key := fmt.Sprintf("offers/%s/%s/%d/%v/%s/%d", converterPairKey(converterPair),
+		converterPair.Amount.String(), converterPair.TradeSide, converterPair.Providers,
+		advertiserFilterKey(converterPair.AdvertiserFilter), limit)
+
+	value, err := q.get(ctx, key, q.ttl(converterPair), func(ctx context.Context) (interface{},
+		error) {
+		return q.api.GetOffers(ctx, converterPair, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]core.Offer), nil
+}
+
+// ttl returns TTL of quotes of the converter pair
+func (q *QuoteCache) ttl(converterPair core.ConverterPair) time.Duration {
+	if ttl, ok := q.pairTTLs[converterPairKey(converterPair)]; ok {
+		return ttl
+	}
+	return q.cfg.TTL
+}
+
+// get returns cached value of key or fetches it, concurrent callers of the same key wait for one
+// fetch, every caller stops waiting when its own ctx is done
+func (q *QuoteCache) get(ctx context.Context, key string, ttl time.Duration,
+	fetch func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	q.mu.Lock()
+	entry, cached := q.entries[key]
+	if cached && q.now().Sub(entry.fetchedAt) < ttl {
+		q.mu.Unlock()
+		return entry.value, nil
+	}
+
+	call, inFlight := q.calls[key]
+	if !inFlight {
+		call = &quoteCacheCall{done: make(chan struct{})}
+		q.calls[key] = call
+		go q.fetch(key, ttl, call, fetch)
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-call.done:
+	}
+
+	if call.err != nil {
+		if cached && q.now().Sub(entry.fetchedAt) < ttl+q.cfg.MaxStale {
+			logrus.WithFields(logrus.Fields{
+				"key":       key,
+				"fetchedAt": entry.fetchedAt,
+				"error":     call.err.Error(),
+			}).Warn("return stale quote because of upstream error")
+			return entry.value, nil
+		}
+		return nil, call.err
+	}
+
+	return call.value, nil
+}
+
+// fetch runs the upstream request of the call and stores its result, the request is not canceled
+// by callers, so callers which still wait get the result when the first of them gives up
+func (q *QuoteCache) fetch(key string, ttl time.Duration, call *quoteCacheCall,
+	fetch func(ctx context.Context) (interface{}, error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), quoteCacheFetchTimeout)
+	defer cancel()
+
+	call.value, call.err = fetch(ctx)
+
+	q.mu.Lock()
+	if call.err == nil {
+		q.entries[key] = quoteCacheEntry{value: call.value, fetchedAt: q.now(), ttl: ttl}
+		if len(q.entries) > quoteCacheSweepSize {
+			q.sweep()
+		}
+	}
+	delete(q.calls, key)
+	q.mu.Unlock()
+	close(call.done)
+}
+
+// sweep removes entries which can not be returned even as stale ones, q.mu must be held
+func (q *QuoteCache) sweep() {
+	for key, entry := range q.entries {
+		if q.now().Sub(entry.fetchedAt) >= entry.ttl+q.cfg.MaxStale {
+			delete(q.entries, key)
+		}
+	}
+}
+
+// advertiserFilterKey returns the same key for all filters selecting all offers
+func advertiserFilterKey(filter *core.AdvertiserFilter) string {
+	if filter == nil || filter.IsEmpty() {
+		return ""
+	}
+	return fmt.Sprintf("%t,%v,%d,%s", filter.MerchantOnly, filter.MinCompletionRate,
+		filter.MinOrderCount, strings.Join(filter.ExcludedAdvertisers, ","))
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var errorTestUpstream = errors.New("upstream error")
+
+// fakeQuoteApi returns exchange and error set by the test, requests wait for release when it is
+// set
+type fakeQuoteApi struct {
+	mu       sync.Mutex
+	exchange core.Exchange
+	err      error
+	release  chan struct{}
+	calls    int32
+}
+
+func (f *fakeQuoteApi) set(exchange string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.exchange = testDecimal(exchange)
+	f.err = err
+}
+
+func (f *fakeQuoteApi) GetExchange(ctx context.Context,
+	converterPair core.ConverterPair) (core.Exchange, error) {
+	quote, err := f.GetProviderQuote(ctx, converterPair)
+	return quote.Exchange, err
+}
+
+func (f *fakeQuoteApi) GetProviderQuote(ctx context.Context,
+	converterPair core.ConverterPair) (core.ProviderQuote, error) {
+	atomic.AddInt32(&f.calls, 1)
+	if f.release != nil {
+		select {
+		case <-f.release:
+		case <-ctx.Done():
+			return core.ProviderQuote{}, ctx.Err()
+		}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return core.ProviderQuote{Provider: core.ProviderBinanceP2P, Exchange: f.exchange}, f.err
+}
+
+func (f *fakeQuoteApi) GetOffers(ctx context.Context, converterPair core.ConverterPair,
+	limit int) ([]core.Offer, error) {
+	return nil, nil
+}
+
+func newTestQuoteCache(api *fakeQuoteApi, cfg QuoteCacheConfig) (*QuoteCache, *testClock) {
+	clock := newTestClock()
+	cache := NewQuoteCache(api, cfg)
+	cache.now = clock.Now
+	return cache, clock
+}
+
+func requireExchange(t *testing.T, cache *QuoteCache, converterPair core.ConverterPair,
+	want string) {
+	t.Helper()
+	exchange, err := cache.GetExchange(context.Background(), converterPair)
+	if err != nil {
+		t.Fatalf("get exchange: %v", err)
+	}
+	if !exchange.Equal(testDecimal(want)) {
+		t.Fatalf("exchange = %v, want %v", exchange, want)
+	}
+}
+
+func TestQuoteCacheTTL(t *testing.T) {
+	api := &fakeQuoteApi{}
+	api.set("90", nil)
+	cache, clock := newTestQuoteCache(api, QuoteCacheConfig{TTL: 10 * time.Second})
+	pair := testPair(testUsdt, testRubTinkoff)
+
+	requireExchange(t, cache, pair, "90")
+	api.set("91", nil)
+	clock.Advance(9 * time.Second)
+	requireExchange(t, cache, pair, "90")
+	if calls := atomic.LoadInt32(&api.calls); calls != 1 {
+		t.Fatalf("upstream calls = %d, want 1", calls)
+	}
+
+	clock.Advance(time.Second)
+	requireExchange(t, cache, pair, "91")
+	if calls := atomic.LoadInt32(&api.calls); calls != 2 {
+		t.Fatalf("upstream calls = %d, want 2", calls)
+	}
+}
+
+func TestQuoteCachePairTTL(t *testing.T) {
+	api := &fakeQuoteApi{}
+	api.set("90", nil)
+	fastPair := testPair(testUsdt, testRubTinkoff)
+	slowPair := testPair(testUsdt, testKztKaspi)
+	cache, clock := newTestQuoteCache(api, QuoteCacheConfig{
+		TTL: 10 * time.Second,
+		PairTTLs: []QuoteCachePairTTL{
+			{ConverterPair: fastPair, TTL: time.Second},
+		},
+	})
+
+	requireExchange(t, cache, fastPair, "90")
+	requireExchange(t, cache, slowPair, "90")
+	api.set("91", nil)
+	clock.Advance(time.Second)
+
+	requireExchange(t, cache, fastPair, "91")
+	requireExchange(t, cache, slowPair, "90")
+}
+
+func TestQuoteCacheStaleIfError(t *testing.T) {
+	api := &fakeQuoteApi{}
+	api.set("90", nil)
+	cache, clock := newTestQuoteCache(api, QuoteCacheConfig{
+		TTL:      10 * time.Second,
+		MaxStale: time.Minute,
+	})
+	pair := testPair(testUsdt, testRubTinkoff)
+
+	requireExchange(t, cache, pair, "90")
+	api.set("0", errorTestUpstream)
+
+	clock.Advance(10*time.Second + time.Minute - time.Second)
+	requireExchange(t, cache, pair, "90")
+
+	clock.Advance(time.Second)
+	if _, err := cache.GetExchange(context.Background(), pair); err != errorTestUpstream {
+		t.Fatalf("error = %v, want %v", err, errorTestUpstream)
+	}
+}
+
+func TestQuoteCacheSingleFlight(t *testing.T) {
+	api := &fakeQuoteApi{release: make(chan struct{})}
+	api.set("90", nil)
+	cache, _ := newTestQuoteCache(api, QuoteCacheConfig{})
+	pair := testPair(testUsdt, testRubTinkoff)
+
+	// the first caller gives up, the request goes on for the other callers
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := cache.GetExchange(leaderCtx, pair)
+		leaderErr <- err
+	}()
+	waitCalls(t, api, 1)
+
+	const waiters = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, waiters)
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			exchange, err := cache.GetExchange(context.Background(), pair)
+			if err == nil && !exchange.Equal(testDecimal("90")) {
+				err = errors.New("unexpected exchange " + exchange.String())
+			}
+			errs <- err
+		}()
+	}
+
+	cancelLeader()
+	if err := <-leaderErr; err != context.Canceled {
+		t.Fatalf("leader error = %v, want %v", err, context.Canceled)
+	}
+
+	close(api.release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("waiter: %v", err)
+		}
+	}
+	if calls := atomic.LoadInt32(&api.calls); calls != 1 {
+		t.Fatalf("upstream calls = %d, want 1", calls)
+	}
+}
+
+func TestQuoteCacheWaiterContext(t *testing.T) {
+	api := &fakeQuoteApi{release: make(chan struct{})}
+	api.set("90", nil)
+	cache, _ := newTestQuoteCache(api, QuoteCacheConfig{})
+	pair := testPair(testUsdt, testRubTinkoff)
+
+	leader := make(chan error, 1)
+	go func() {
+		_, err := cache.GetExchange(context.Background(), pair)
+		leader <- err
+	}()
+	waitCalls(t, api, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := cache.GetExchange(ctx, pair); err != context.DeadlineExceeded {
+		t.Fatalf("waiter error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(api.release)
+	if err := <-leader; err != nil {
+		t.Fatalf("leader: %v", err)
+	}
+}
+
+func waitCalls(t *testing.T, api *fakeQuoteApi, calls int32) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&api.calls) < calls {
+		if time.Now().After(deadline) {
+			t.Fatalf("upstream calls = %d, want %d", atomic.LoadInt32(&api.calls), calls)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
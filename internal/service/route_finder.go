@@ -0,0 +1,202 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"sort"
+	"sync"
+)
+
+const (
+	defaultRouteMaxLegs   = 2
+	maxRouteMaxLegs       = 4
+	defaultRoutesLimit    = 5
+	maxRoutesLimit        = 20
+	maxRouteCandidates    = 50
+	routeQuoteConcurrency = 4
+)
+
+type RouteFinderUserDb interface {
+	GetConverterPairs(ctx context.Context) ([]core.ConverterPair, error)
+	GetAvailableClassicCurrencies(ctx context.Context) ([]core.CurrencyCode, error)
+	GetAvailableBanks(ctx context.Context, currency core.CurrencyCode) ([]core.CurrencyBank, error)
+	GetAvailableCryptoCurrencies(ctx context.Context) ([]core.CurrencyCode, error)
+	GetUserAdvertiserFilter(ctx context.Context, userId int) (core.AdvertiserFilter, error)
+}
+
+type RouteFinderQuoter interface {
+	QuoteRoute(ctx context.Context, converterPair core.ConverterPair) (core.RouteQuote, error)
+}
+
+// RouteFinder searches routes between two currencies over known currencies and banks and ranks
+// them by effective rate
+type RouteFinder struct {
+	userDb RouteFinderUserDb
+	quoter RouteFinderQuoter
+}
+
+func NewRouteFinder(userDb RouteFinderUserDb, quoter RouteFinderQuoter) *RouteFinder {
+	return &RouteFinder{userDb: userDb, quoter: quoter}
+}
+
+// FindRoutes returns quoted routes from source currency to target one, the best route is the
+// first one, routes which fail to be quoted are skipped
+func (r *RouteFinder) FindRoutes(ctx context.Context,
+	params core.RouteParams) ([]core.RouteQuote, error) {
+	if params.Source == params.Target {
+		return nil, core.ErrorRouteInvalidTarget
+	}
+	if params.Amount.IsNegative() {
+		return nil, core.ErrorConverterInvalidAmount
+	}
+	if params.MaxLegs == 0 {
+		params.MaxLegs = defaultRouteMaxLegs
+	}
+	if params.MaxLegs < 0 || params.MaxLegs > maxRouteMaxLegs {
+		return nil, core.ErrorRouteInvalidMaxLegs
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultRoutesLimit
+	}
+	if params.Limit < 0 || params.Limit > maxRoutesLimit {
+		return nil, core.ErrorRouteInvalidLimit
+	}
+
+	graph, err := buildCurrencyGraph(ctx, r.userDb)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := graph.Routes(params.Source, params.Target, params.MaxLegs)
+	if len(routes) == 0 {
+		return nil, core.ErrorRouteNotFound
+	}
+	// shorter routes are preferred when candidates are truncated
+	sort.SliceStable(routes, func(i, j int) bool {
+		return len(routes[i].Currencies) < len(routes[j].Currencies)
+	})
+	if len(routes) > maxRouteCandidates {
+		routes = routes[:maxRouteCandidates]
+	}
+
+	// filter is resolved once for all candidates
+	filterPair, err := resolveAdvertiserFilter(ctx, r.userDb, core.ConverterPair{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range routes {
+		routes[i].Amount = params.Amount
+		routes[i].Providers = params.Providers
+		routes[i].AdvertiserFilter = filterPair.AdvertiserFilter
+	}
+	quotes, err := quoteRoutes(ctx, r.quoter, routes)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(quotes, func(i, j int) bool {
+		return quotes[i].Rate.GreaterThan(quotes[j].Rate)
+	})
+	if len(quotes) > params.Limit {
+		quotes = quotes[:params.Limit]
+	}
+
+	return quotes, nil
+}
+
+// quoteRoutes quotes routes concurrently, routes which fail to be quoted are skipped and error is
+// returned only when all of them fail
+func quoteRoutes(ctx context.Context, quoter RouteFinderQuoter,
+	routes []core.ConverterPair) ([]core.RouteQuote, error) {
+	quotes := make([]core.RouteQuote, len(routes))
+	errs := make([]error, len(routes))
+
+	semaphore := make(chan struct{}, routeQuoteConcurrency)
+	var wg sync.WaitGroup
+	for i, route := range routes {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, route core.ConverterPair) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			quotes[i], errs[i] = quoter.QuoteRoute(ctx, route)
+		}(i, route)
+	}
+	wg.Wait()
+
+	var quoted []core.RouteQuote
+	var firstErr error
+	for i := range routes {
+		if errs[i] != nil {
+			logrus.WithFields(logrus.Fields{
+				"route": routes[i],
+				"error": errs[i].Error(),
+			}).Warn("error quote route")
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		quoted = append(quoted, quotes[i])
+	}
+	if len(quoted) == 0 {
+		return nil, firstErr
+	}
+
+	return quoted, nil
+}
+
+// buildCurrencyGraph links each known classic currency of each bank with each known crypto
+// currency, as they are traded on p2p, and adds legs of known converter pairs
+func buildCurrencyGraph(ctx context.Context, userDb RouteFinderUserDb) (*CurrencyGraph, error) {
+	converterPairs, err := userDb.GetConverterPairs(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error get converter pairs")
+		return nil, err
+	}
+	graph := NewCurrencyGraph(converterPairs)
+
+	cryptoCodes, err := userDb.GetAvailableCryptoCurrencies(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error get available crypto currencies")
+		return nil, err
+	}
+	classicCodes, err := userDb.GetAvailableClassicCurrencies(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error get available classic currencies")
+		return nil, err
+	}
+
+	for _, classicCode := range classicCodes {
+		banks, err := userDb.GetAvailableBanks(ctx, classicCode)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"currency": classicCode,
+				"error":    err.Error(),
+			}).Error("error get available banks")
+			return nil, err
+		}
+		for _, bank := range banks {
+			classic := core.FullCurrency{
+				CurrencyType: core.CurrencyTypeClassic,
+				CurrencyCode: classicCode,
+				BankCode:     bank,
+			}
+			for _, cryptoCode := range cryptoCodes {
+				graph.AddEdge(classic, core.FullCurrency{
+					CurrencyType: core.CurrencyTypeCrypto,
+					CurrencyCode: cryptoCode,
+				})
+			}
+		}
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"reflect"
+	"testing"
+)
+
+type fakeRouteFinderUserDb struct {
+	converterPairs []core.ConverterPair
+	classicCodes   []core.CurrencyCode
+	banks          map[core.CurrencyCode][]core.CurrencyBank
+	cryptoCodes    []core.CurrencyCode
+}
+
+func (f *fakeRouteFinderUserDb) GetConverterPairs(ctx context.Context) ([]core.ConverterPair,
+	error) {
+	return f.converterPairs, nil
+}
+
+func (f *fakeRouteFinderUserDb) GetAvailableClassicCurrencies(
+	ctx context.Context) ([]core.CurrencyCode, error) {
+	return f.classicCodes, nil
+}
+
+func (f *fakeRouteFinderUserDb) GetAvailableBanks(ctx context.Context,
+	currency core.CurrencyCode) ([]core.CurrencyBank, error) {
+	return f.banks[currency], nil
+}
+
+func (f *fakeRouteFinderUserDb) GetAvailableCryptoCurrencies(
+	ctx context.Context) ([]core.CurrencyCode, error) {
+	return f.cryptoCodes, nil
+}
+
+func (f *fakeRouteFinderUserDb) GetUserAdvertiserFilter(ctx context.Context,
+	userId int) (core.AdvertiserFilter, error) {
+	return core.AdvertiserFilter{}, nil
+}
+
+// fakeRouteFinderQuoter quotes routes by their codes, unknown routes fail
+type fakeRouteFinderQuoter struct {
+	rates map[string]string
+}
+
+func (f *fakeRouteFinderQuoter) QuoteRoute(ctx context.Context,
+	converterPair core.ConverterPair) (core.RouteQuote, error) {
+	rate, ok := f.rates[routeCodes([]core.ConverterPair{converterPair})[0]]
+	if !ok {
+		return core.RouteQuote{}, errors.New("no quote")
+	}
+	return core.RouteQuote{ConverterPair: converterPair, Rate: testDecimal(rate)}, nil
+}
+
+func newTestRouteFinderUserDb() *fakeRouteFinderUserDb {
+	return &fakeRouteFinderUserDb{
+		// crypto currencies are linked only by known converter pairs
+		converterPairs: []core.ConverterPair{testPair(testUsdt, testBtc)},
+		classicCodes:   []core.CurrencyCode{"RUB", "KZT"},
+		banks: map[core.CurrencyCode][]core.CurrencyBank{
+			"RUB": {"TinkoffNew"},
+			"KZT": {"KaspiBank"},
+		},
+		cryptoCodes: []core.CurrencyCode{"USDT", "BTC"},
+	}
+}
+
+func TestRouteFinderFindRoutes(t *testing.T) {
+	quoter := &fakeRouteFinderQuoter{rates: map[string]string{
+		"RUB USDT KZT":     "5.1",
+		"RUB BTC KZT":      "5.3",
+		"RUB USDT BTC KZT": "5.5",
+	}}
+
+	tests := []struct {
+		name    string
+		params  core.RouteParams
+		want    []string
+		wantErr error
+	}{
+		{
+			name:   "default max legs, unquoted route is skipped",
+			params: core.RouteParams{Source: testRubTinkoff, Target: testKztKaspi},
+			want:   []string{"RUB BTC KZT", "RUB USDT KZT"},
+		},
+		{
+			name: "longer route is better",
+			params: core.RouteParams{Source: testRubTinkoff, Target: testKztKaspi,
+				MaxLegs: 3},
+			want: []string{"RUB USDT BTC KZT", "RUB BTC KZT", "RUB USDT KZT"},
+		},
+		{
+			name: "limit",
+			params: core.RouteParams{Source: testRubTinkoff, Target: testKztKaspi,
+				MaxLegs: 3, Limit: 1},
+			want: []string{"RUB USDT BTC KZT"},
+		},
+		{
+			name:    "no quoted routes",
+			params:  core.RouteParams{Source: testKztKaspi, Target: testRubTinkoff},
+			wantErr: errors.New("no quote"),
+		},
+		{
+			name:    "unknown target",
+			params:  core.RouteParams{Source: testRubTinkoff, Target: testEurWise},
+			wantErr: core.ErrorRouteNotFound,
+		},
+		{
+			name:    "source equals target",
+			params:  core.RouteParams{Source: testRubTinkoff, Target: testRubTinkoff},
+			wantErr: core.ErrorRouteInvalidTarget,
+		},
+		{
+			name: "too many legs",
+			params: core.RouteParams{Source: testRubTinkoff, Target: testKztKaspi,
+				MaxLegs: maxRouteMaxLegs + 1},
+			wantErr: core.ErrorRouteInvalidMaxLegs,
+		},
+		{
+			name: "invalid limit",
+			params: core.RouteParams{Source: testRubTinkoff, Target: testKztKaspi,
+				Limit: -1},
+			wantErr: core.ErrorRouteInvalidLimit,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			finder := NewRouteFinder(newTestRouteFinderUserDb(), quoter)
+			quotes, err := finder.FindRoutes(context.Background(), test.params)
+			if test.wantErr != nil {
+				if err == nil || err.Error() != test.wantErr.Error() {
+					t.Fatalf("error = %v, want %v", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			routes := make([]core.ConverterPair, 0, len(quotes))
+			for _, quote := range quotes {
+				routes = append(routes, quote.ConverterPair)
+			}
+			if got := routeCodes(routes); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("routes = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"fmt"
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"strings"
+	"time"
+)
+
+const (
+	defaultThresholdWatcherInterval   = time.Minute
+	defaultThresholdWatcherHysteresis = 0.005
+	defaultThresholdWatcherCooldown   = 15 * time.Minute
+	thresholdAlertsBufferSize         = 256
+)
+
+type ThresholdWatcherUserDb interface {
+	GetAllThresholdConvertPairs(ctx context.Context) ([]core.UserThresholdConvertPair, error)
+}
+
+type ThresholdWatcherConverter interface {
+	GetCurrentExchange(ctx context.Context, converterPair core.ConverterPair) (core.Exchange,
+		error)
+}
+
+// ThresholdWatcherConfig zero values are replaced with defaults
+type ThresholdWatcherConfig struct {
+	// Interval between two evaluations of all thresholds
+	Interval time.Duration
+	// Hysteresis is a relative distance from the threshold the exchange has to move away before
+	// the threshold can fire again
+	Hysteresis float64
+	// Cooldown is a minimal time between two alerts of the same threshold
+	Cooldown time.Duration
+}
+
+type thresholdState struct {
+	armed     bool
+	lastFired time.Time
+}
+
+// ThresholdWatcher evaluates thresholds of all users and emits alerts of crossed ones. Watchers of
+// several replicas do not coordinate, so a single replica is expected to run it, otherwise every
+// crossing is alerted once per replica
+type ThresholdWatcher struct {
+	userDb    ThresholdWatcherUserDb
+	converter ThresholdWatcherConverter
+	cfg       ThresholdWatcherConfig
+
+	lastExchanges map[string]core.Exchange
+	states        map[string]*thresholdState
+	alerts        chan core.ThresholdAlert
+	// now is replaced in tests
+	now func() time.Time
+}
+
+func NewThresholdWatcher(userDb ThresholdWatcherUserDb, converter ThresholdWatcherConverter,
+	cfg ThresholdWatcherConfig) *ThresholdWatcher {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultThresholdWatcherInterval
+	}
+	if cfg.Hysteresis <= 0 {
+		cfg.Hysteresis = defaultThresholdWatcherHysteresis
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = defaultThresholdWatcherCooldown
+	}
+	return &ThresholdWatcher{
+		userDb:        userDb,
+		converter:     converter,
+		cfg:           cfg,
+		lastExchanges: make(map[string]core.Exchange),
+		states:        make(map[string]*thresholdState),
+		alerts:        make(chan core.ThresholdAlert, thresholdAlertsBufferSize),
+		now:           time.Now,
+	}
+}
+
+// Alerts returns channel with threshold crossing alerts. The channel is closed when Run returns.
+func (t *ThresholdWatcher) Alerts() <-chan core.ThresholdAlert {
+	return t.alerts
+}
+
+// Run evaluates thresholds every cfg.Interval until ctx is done
+func (t *ThresholdWatcher) Run(ctx context.Context) {
+	defer close(t.alerts)
+
+	ticker := time.NewTicker(t.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		t.Evaluate(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate runs one pass over all thresholds and sends alerts for every crossed threshold
+func (t *ThresholdWatcher) Evaluate(ctx context.Context) {
+	thresholds, err := t.userDb.GetAllThresholdConvertPairs(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error get thresholds of converter pairs")
+		return
+	}
+
+	pairs := make(map[string]core.ConverterPair)
+	pairThresholds := make(map[string][]core.UserThresholdConvertPair)
+	for _, threshold := range thresholds {
+		key := converterPairKey(threshold.Threshold.ConverterPair)
+		pairs[key] = threshold.Threshold.ConverterPair
+		pairThresholds[key] = append(pairThresholds[key], threshold)
+	}
+
+	activeStates := make(map[string]*thresholdState)
+	for key, pair := range pairs {
+		exchange, err := t.converter.GetCurrentExchange(ctx, pair)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"converterPair": pair,
+				"error":         err.Error(),
+			}).Error("error get current exchange for thresholds")
+			for _, threshold := range pairThresholds[key] {
+				stateKey := thresholdStateKey(threshold)
+				if state, ok := t.states[stateKey]; ok {
+					activeStates[stateKey] = state
+				}
+			}
+			continue
+		}
+
+		lastExchange, hasLastExchange := t.lastExchanges[key]
+		t.lastExchanges[key] = exchange
+
+		for _, threshold := range pairThresholds[key] {
+			stateKey := thresholdStateKey(threshold)
+			state, ok := t.states[stateKey]
+			if !ok {
+				state = &thresholdState{armed: true}
+			}
+			activeStates[stateKey] = state
+
+			if !hasLastExchange {
+				continue
+			}
+
+			alert, fired := t.check(state, threshold, lastExchange, exchange)
+			if !fired {
+				continue
+			}
+
+			select {
+			case t.alerts <- alert:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+
+	for key := range t.lastExchanges {
+		if _, ok := pairs[key]; !ok {
+			delete(t.lastExchanges, key)
+		}
+	}
+	t.states = activeStates
+}
+
+func (t *ThresholdWatcher) check(state *thresholdState, threshold core.UserThresholdConvertPair,
+	oldExchange, newExchange core.Exchange) (core.ThresholdAlert, bool) {
+	line := threshold.Threshold.Exchange
+	hysteresis := line.Mul(decimal.NewFromFloat(t.cfg.Hysteresis))
+
+	if !state.armed && newExchange.Sub(line).Abs().GreaterThanOrEqual(hysteresis) {
+		state.armed = true
+	}
+
+	var direction core.AlertDirection
+	switch {
+	case oldExchange.LessThan(line) && newExchange.GreaterThanOrEqual(line):
+		direction = core.AlertDirectionUp
+	case oldExchange.GreaterThan(line) && newExchange.LessThanOrEqual(line):
+		direction = core.AlertDirectionDown
+	default:
+		return core.ThresholdAlert{}, false
+	}
+
+	now := t.now()
+	if !state.armed || now.Sub(state.lastFired) < t.cfg.Cooldown {
+		return core.ThresholdAlert{}, false
+	}
+	state.armed = false
+	state.lastFired = now
+
+	return core.ThresholdAlert{
+		UserId:        threshold.UserId,
+		ChatId:        threshold.ChatId,
+		ConverterPair: threshold.Threshold.ConverterPair,
+		OldExchange:   oldExchange,
+		NewExchange:   newExchange,
+		Threshold:     threshold.Threshold.Exchange,
+		Direction:     direction,
+		CreatedAt:     now,
+	}, true
+}
+
+func converterPairKey(converterPair core.ConverterPair) string {
+	keys := make([]string, 0, len(converterPair.Currencies))
+	for _, currency := range converterPair.Currencies {
+		keys = append(keys, currencyKey(currency))
+	}
+	return strings.Join(keys, "/")
+}
+
+func thresholdStateKey(threshold core.UserThresholdConvertPair) string {
+	return fmt.Sprintf("%d/%s/%v", threshold.UserId,
+		converterPairKey(threshold.Threshold.ConverterPair), threshold.Threshold.Exchange)
+}
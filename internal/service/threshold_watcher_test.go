@@ -0,0 +1,177 @@
+package service
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"testing"
+	"time"
+)
+
+type fakeThresholdWatcherUserDb struct {
+	thresholds []core.UserThresholdConvertPair
+}
+
+func (f *fakeThresholdWatcherUserDb) GetAllThresholdConvertPairs(
+	ctx context.Context) ([]core.UserThresholdConvertPair, error) {
+	return f.thresholds, nil
+}
+
+// fakeThresholdWatcherConverter returns exchanges of the sequence one per call
+type fakeThresholdWatcherConverter struct {
+	exchanges []core.Exchange
+}
+
+func (f *fakeThresholdWatcherConverter) GetCurrentExchange(ctx context.Context,
+	converterPair core.ConverterPair) (core.Exchange, error) {
+	exchange := f.exchanges[0]
+	if len(f.exchanges) > 1 {
+		f.exchanges = f.exchanges[1:]
+	}
+	return exchange, nil
+}
+
+func testThreshold(line string) core.UserThresholdConvertPair {
+	return core.UserThresholdConvertPair{
+		UserId: 1,
+		ChatId: 10,
+		Threshold: core.ThresholdConvertPair{
+			ConverterPair: testPair(testUsdt, testRubTinkoff),
+			Exchange:      testDecimal(line),
+		},
+	}
+}
+
+func TestThresholdWatcherCheck(t *testing.T) {
+	const cooldown = 10 * time.Minute
+
+	tests := []struct {
+		name string
+		// disarmed state is left by the previous alert
+		disarmed bool
+		// sinceFired is a time passed since the previous alert, zero means never fired
+		sinceFired time.Duration
+		old, new   string
+		fired      bool
+		direction  core.AlertDirection
+		armedAfter bool
+	}{
+		{name: "cross up", old: "99", new: "100.5", fired: true,
+			direction: core.AlertDirectionUp},
+		{name: "cross down", old: "101", new: "99", fired: true,
+			direction: core.AlertDirectionDown},
+		{name: "reach line from below", old: "99", new: "100", fired: true,
+			direction: core.AlertDirectionUp},
+		{name: "leave line upwards", old: "100", new: "101", armedAfter: true},
+		{name: "stay below", old: "98", new: "99.5", armedAfter: true},
+		{name: "disarmed inside band", disarmed: true, sinceFired: time.Hour, old: "100.5",
+			new: "99.5"},
+		{name: "disarmed rearmed at band edge", disarmed: true, sinceFired: time.Hour,
+			old: "100.5", new: "99", fired: true, direction: core.AlertDirectionDown},
+		{name: "disarmed rearmed beyond band", disarmed: true, sinceFired: time.Hour,
+			old: "101", new: "98", fired: true, direction: core.AlertDirectionDown},
+		{name: "rearmed without crossing", disarmed: true, sinceFired: time.Hour, old: "100.5",
+			new: "101", armedAfter: true},
+		{name: "cooldown", sinceFired: cooldown - time.Second, old: "99", new: "101",
+			armedAfter: true},
+		{name: "cooldown passed", sinceFired: cooldown, old: "99", new: "101", fired: true,
+			direction: core.AlertDirectionUp},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clock := newTestClock()
+			watcher := NewThresholdWatcher(&fakeThresholdWatcherUserDb{},
+				&fakeThresholdWatcherConverter{}, ThresholdWatcherConfig{
+					Hysteresis: 0.01,
+					Cooldown:   cooldown,
+				})
+			watcher.now = clock.Now
+
+			state := &thresholdState{armed: !test.disarmed}
+			if test.sinceFired > 0 {
+				state.lastFired = clock.Now().Add(-test.sinceFired)
+			}
+			alert, fired := watcher.check(state, testThreshold("100"), testDecimal(test.old),
+				testDecimal(test.new))
+
+			if fired != test.fired {
+				t.Fatalf("fired = %v, want %v", fired, test.fired)
+			}
+			if fired {
+				if alert.Direction != test.direction {
+					t.Errorf("direction = %v, want %v", alert.Direction, test.direction)
+				}
+				if !alert.CreatedAt.Equal(clock.Now()) || !state.lastFired.Equal(clock.Now()) {
+					t.Errorf("alert is not stamped with the clock: %v, %v", alert.CreatedAt,
+						state.lastFired)
+				}
+				if !alert.OldExchange.Equal(testDecimal(test.old)) ||
+					!alert.NewExchange.Equal(testDecimal(test.new)) {
+					t.Errorf("exchanges of alert = %v -> %v", alert.OldExchange,
+						alert.NewExchange)
+				}
+			}
+			if state.armed != test.armedAfter {
+				t.Errorf("armed = %v, want %v", state.armed, test.armedAfter)
+			}
+		})
+	}
+}
+
+func TestThresholdWatcherEvaluate(t *testing.T) {
+	clock := newTestClock()
+	converter := &fakeThresholdWatcherConverter{
+		exchanges: []core.Exchange{
+			// first tick only records the exchange even beyond the threshold
+			testDecimal("105"),
+			// crossing down
+			testDecimal("99"),
+			// back up inside the band, disarmed
+			testDecimal("100.5"),
+			// down again inside the band, no alert
+			testDecimal("99.5"),
+			// up beyond the band rearms and fires after cooldown
+			testDecimal("102"),
+		},
+	}
+	watcher := NewThresholdWatcher(&fakeThresholdWatcherUserDb{
+		thresholds: []core.UserThresholdConvertPair{testThreshold("100")},
+	}, converter, ThresholdWatcherConfig{
+		Hysteresis: 0.01,
+		Cooldown:   time.Minute,
+	})
+	watcher.now = clock.Now
+
+	ctx := context.Background()
+	want := []struct {
+		fired     bool
+		direction core.AlertDirection
+	}{
+		{},
+		{fired: true, direction: core.AlertDirectionDown},
+		{},
+		{},
+		{fired: true, direction: core.AlertDirectionUp},
+	}
+	for i, tick := range want {
+		clock.Advance(time.Minute)
+		watcher.Evaluate(ctx)
+
+		select {
+		case alert := <-watcher.Alerts():
+			if !tick.fired {
+				t.Fatalf("tick %d: unexpected alert %+v", i, alert)
+			}
+			if alert.Direction != tick.direction {
+				t.Fatalf("tick %d: direction = %v, want %v", i, alert.Direction, tick.direction)
+			}
+			if alert.UserId != 1 || alert.ChatId != 10 {
+				t.Fatalf("tick %d: alert of user %d chat %d", i, alert.UserId, alert.ChatId)
+			}
+		default:
+			if tick.fired {
+				t.Fatalf("tick %d: alert is not sent", i)
+			}
+		}
+	}
+}
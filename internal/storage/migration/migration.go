@@ -0,0 +1,68 @@
+// Package migration reads versioned sql migrations shared by storage backends
+package migration
+
+import (
+	"github.com/binance-converter/backend/core"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+var fileNameRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Load returns migrations of NNNNNN_name.up.sql and NNNNNN_name.down.sql files of the root of fsys
+// ordered by version, other files are ignored
+func Load(fsys fs.FS) ([]core.Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make(map[int]*core.Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileNameRegexp.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.Atoi(match[1])
+		if err != nil || version <= 0 {
+			return nil, core.ErrorMigrationInvalidName
+		}
+
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := migrations[version]
+		if !ok {
+			migration = &core.Migration{Version: version, Name: match[2]}
+			migrations[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, core.ErrorMigrationDuplicateVersion
+		}
+		if match[3] == "up" {
+			migration.Up = string(body)
+		} else {
+			migration.Down = string(body)
+		}
+	}
+
+	sorted := make([]core.Migration, 0, len(migrations))
+	for _, migration := range migrations {
+		// down file without up one is a typo in the name of one of them
+		if migration.Up == "" {
+			return nil, core.ErrorMigrationInvalidName
+		}
+		sorted = append(sorted, *migration)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+	return sorted, nil
+}
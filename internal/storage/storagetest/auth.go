@@ -0,0 +1,112 @@
+package storagetest
+
+import (
+	"context"
+	"github.com/binance-converter/backend/core"
+	"testing"
+)
+
+func testSignUp(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	chatId := int64(1001)
+	userName, firstName, lastName, languageCode := "user", "First", "Last", "en"
+	userId, err := db.AddUser(ctx, core.AddUser{
+		ChatId:       &chatId,
+		UserName:     &userName,
+		FirstName:    &firstName,
+		LastName:     &lastName,
+		LanguageCode: &languageCode,
+	})
+	requireNoError(t, err, "add user")
+
+	validatedId, err := db.ValidateUser(ctx, int(chatId))
+	requireNoError(t, err, "validate user")
+	if validatedId != userId {
+		t.Fatalf("validated user id %d, want %d", validatedId, userId)
+	}
+
+	secondId := addUser(t, db, 1002)
+	if secondId == userId {
+		t.Fatalf("users got the same id %d", userId)
+	}
+
+	users, err := db.GetUsers(ctx)
+	requireNoError(t, err, "get users")
+	if len(users) != 2 {
+		t.Fatalf("got %d users, want 2", len(users))
+	}
+	user := users[0]
+	if user.Id != userId || user.ChatId == nil || *user.ChatId != chatId ||
+		user.UserName == nil || *user.UserName != userName || user.FirstName != firstName ||
+		user.LastName != lastName || user.LanguageCode == nil ||
+		*user.LanguageCode != languageCode || user.IsAdmin {
+		t.Fatalf("got user %+v", user)
+	}
+	if users[1].Id != secondId {
+		t.Fatalf("users are not ordered by id: %+v", users)
+	}
+}
+
+func testDuplicateUser(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	addUser(t, db, 1001)
+
+	chatId := int64(1001)
+	firstName, lastName := "Other", "User"
+	_, err := db.AddUser(ctx, core.AddUser{
+		ChatId:    &chatId,
+		FirstName: &firstName,
+		LastName:  &lastName,
+	})
+	requireError(t, err, core.ErrorAuthServiceAuthUserAlreadyExists, "add duplicate user")
+
+	users, err := db.GetUsers(ctx)
+	requireNoError(t, err, "get users")
+	if len(users) != 1 {
+		t.Fatalf("got %d users after duplicate sign up, want 1", len(users))
+	}
+}
+
+func testUnknownUser(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	_, err := db.ValidateUser(ctx, 1001)
+	requireError(t, err, core.ErrorAuthServiceUserNotFound, "validate unknown user")
+
+	isAdmin, err := db.IsAdmin(ctx, 1)
+	requireNoError(t, err, "check admin role of unknown user")
+	if isAdmin {
+		t.Fatal("unknown user is admin")
+	}
+
+	err = db.SetAdmin(ctx, 1, true)
+	requireError(t, err, core.ErrorAuthServiceUserNotFound, "set admin role of unknown user")
+
+	users, err := db.GetUsers(ctx)
+	requireNoError(t, err, "get users")
+	if len(users) != 0 {
+		t.Fatalf("got users %+v of empty storage", users)
+	}
+}
+
+func testAdmin(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+
+	requireNoError(t, db.SetAdmin(ctx, userId, true), "grant admin role")
+	isAdmin, err := db.IsAdmin(ctx, userId)
+	requireNoError(t, err, "check admin role")
+	if !isAdmin {
+		t.Fatal("admin role is not granted")
+	}
+
+	requireNoError(t, db.SetAdmin(ctx, userId, false), "revoke admin role")
+	isAdmin, err = db.IsAdmin(ctx, userId)
+	requireNoError(t, err, "check admin role")
+	if isAdmin {
+		t.Fatal("admin role is not revoked")
+	}
+}
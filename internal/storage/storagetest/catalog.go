@@ -0,0 +1,196 @@
+package storagetest
+
+import (
+	"context"
+	"github.com/binance-converter/backend/core"
+	"testing"
+)
+
+func testCatalogCurrencies(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	firstId, err := db.AddCurrencyIfHasNot(ctx, rubTinkoff)
+	requireNoError(t, err, "add currency")
+	id, err := db.AddCurrencyIfHasNot(ctx, rubTinkoff)
+	requireNoError(t, err, "add existing currency")
+	if id != firstId {
+		t.Fatalf("currency is added twice with ids %d and %d", firstId, id)
+	}
+	_, err = db.AddCurrencyIfHasNot(ctx, usdt)
+	requireNoError(t, err, "add crypto currency")
+
+	_, err = db.AddCurrencyIfHasNot(ctx, core.FullCurrency{CurrencyType: 100, CurrencyCode: "X"})
+	requireError(t, err, core.ErrorCurrencyInvalidCurrencyType, "add currency of invalid type")
+
+	requireNoError(t, db.SetCurrencyDisabled(ctx, rubTinkoff, true), "disable currency")
+	err = db.SetCurrencyDisabled(ctx, kztKaspi, true)
+	requireError(t, err, core.ErrorCurrencyNotFound, "disable unknown currency")
+
+	classic, err := db.GetAvailableClassicCurrencies(ctx)
+	requireNoError(t, err, "get classic currencies")
+	if len(classic) != 0 {
+		t.Fatalf("disabled currency is available: %v", classic)
+	}
+
+	currencies, err := db.GetCatalogCurrencies(ctx)
+	requireNoError(t, err, "get catalog currencies")
+	want := []core.CatalogCurrency{{Currency: rubTinkoff, Disabled: true}, {Currency: usdt}}
+	if len(currencies) != len(want) {
+		t.Fatalf("got catalog currencies %v, want %v", currencies, want)
+	}
+	for i := range want {
+		if currencies[i] != want[i] {
+			t.Fatalf("got catalog currencies %v, want %v", currencies, want)
+		}
+	}
+
+	requireNoError(t, db.SetCurrencyDisabled(ctx, rubTinkoff, false), "enable currency")
+	classic, err = db.GetAvailableClassicCurrencies(ctx)
+	requireNoError(t, err, "get classic currencies")
+	requireCodes(t, classic, "RUB")
+}
+
+func testCatalogConverterPairs(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	addConverterPair(t, db, pair(rubTinkoff, usdt))
+	addConverterPair(t, db, pair(usdt, kztKaspi))
+	addConverterPair(t, db, pair(kztKaspi, usdt))
+
+	requireNoError(t, db.SetConverterPairDisabled(ctx, pair(rubTinkoff, usdt), true),
+		"disable converter pair")
+	requireNoError(t, db.SetCurrencyDisabled(ctx, kztKaspi, true), "disable currency")
+
+	err := db.SetConverterPairDisabled(ctx, pair(usdt, rubTinkoff), true)
+	requireError(t, err, core.ErrorConverterConverterPairNotFound, "disable unknown pair")
+
+	converterPairs, err := db.GetConverterPairs(ctx)
+	requireNoError(t, err, "get converter pairs")
+	requireConverterPairs(t, converterPairs)
+
+	catalog, err := db.GetCatalogConverterPairs(ctx)
+	requireNoError(t, err, "get catalog converter pairs")
+	var catalogPairs []core.ConverterPair
+	var disabled []bool
+	for _, catalogPair := range catalog {
+		catalogPairs = append(catalogPairs, catalogPair.ConverterPair)
+		disabled = append(disabled, catalogPair.Disabled)
+	}
+	requireConverterPairs(t, catalogPairs, pair(rubTinkoff, usdt), pair(usdt, kztKaspi),
+		pair(kztKaspi, usdt))
+	if !disabled[0] || disabled[1] || disabled[2] {
+		t.Fatalf("got disabled flags %v of catalog pairs", disabled)
+	}
+
+	requireNoError(t, db.SetCurrencyDisabled(ctx, kztKaspi, false), "enable currency")
+	requireNoError(t, db.SetConverterPairDisabled(ctx, pair(rubTinkoff, usdt), false),
+		"enable converter pair")
+	converterPairs, err = db.GetConverterPairs(ctx)
+	requireNoError(t, err, "get converter pairs")
+	requireConverterPairs(t, converterPairs, pair(rubTinkoff, usdt), pair(usdt, kztKaspi),
+		pair(kztKaspi, usdt))
+
+	userId := addUser(t, db, 1001)
+	subscribe(t, db, userId, pair(usdt, kztKaspi))
+	requireNoError(t, db.DeleteConverterPair(ctx, pair(usdt, kztKaspi)), "delete converter pair")
+	err = db.DeleteConverterPair(ctx, pair(usdt, kztKaspi))
+	requireError(t, err, core.ErrorConverterConverterPairNotFound, "delete deleted pair")
+
+	converterPairs, err = db.GetUserConverterPairs(ctx, userId)
+	requireNoError(t, err, "get user converter pairs")
+	requireConverterPairs(t, converterPairs)
+}
+
+func testSubscribeDisabledConverterPair(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	addConverterPair(t, db, pair(rubTinkoff, usdt))
+	addConverterPair(t, db, pair(usdt, kztKaspi))
+	subscribe(t, db, userId, pair(rubTinkoff, usdt))
+	subscribe(t, db, userId, pair(usdt, kztKaspi))
+
+	requireNoError(t, db.SetConverterPairDisabled(ctx, pair(rubTinkoff, usdt), true),
+		"disable converter pair")
+	requireNoError(t, db.SetCurrencyDisabled(ctx, kztKaspi, true), "disable currency")
+
+	converterPairs, err := db.GetUserConverterPairs(ctx, userId)
+	requireNoError(t, err, "get user converter pairs")
+	requireConverterPairs(t, converterPairs)
+
+	otherUserId := addUser(t, db, 1002)
+	_, err = db.SetUserConverterPair(ctx, otherUserId, pair(rubTinkoff, usdt))
+	requireError(t, err, core.ErrorConverterConverterPairDisabled, "subscribe to disabled pair")
+	_, err = db.SetUserConverterPair(ctx, otherUserId, pair(usdt, kztKaspi))
+	requireError(t, err, core.ErrorConverterConverterPairDisabled,
+		"subscribe to pair of disabled currency")
+
+	// subscriptions are kept while pairs are disabled
+	requireNoError(t, db.SetConverterPairDisabled(ctx, pair(rubTinkoff, usdt), false),
+		"enable converter pair")
+	requireNoError(t, db.SetCurrencyDisabled(ctx, kztKaspi, false), "enable currency")
+	converterPairs, err = db.GetUserConverterPairs(ctx, userId)
+	requireNoError(t, err, "get user converter pairs")
+	requireConverterPairs(t, converterPairs, pair(rubTinkoff, usdt), pair(usdt, kztKaspi))
+
+	converterPairs, err = db.GetUserConverterPairs(ctx, otherUserId)
+	requireNoError(t, err, "get user converter pairs of other user")
+	requireConverterPairs(t, converterPairs)
+}
+
+func testSubscribeUnknownUser(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	addConverterPair(t, db, pair(rubTinkoff, usdt))
+
+	_, err := db.SetUserConverterPair(ctx, userId+1, pair(rubTinkoff, usdt))
+	requireError(t, err, core.ErrorAuthServiceUserNotFound, "subscribe unknown user")
+
+	converterPairs, err := db.GetUserConverterPairs(ctx, userId)
+	requireNoError(t, err, "get user converter pairs")
+	requireConverterPairs(t, converterPairs)
+}
+
+func testDeleteCurrency(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	addConverterPair(t, db, pair(rubTinkoff, usdt))
+	addConverterPair(t, db, pair(usdt, kztKaspi))
+	subscribe(t, db, userId, pair(rubTinkoff, usdt))
+	requireNoError(t, db.SetThresholdConvertPair(ctx, userId, threshold(pair(rubTinkoff, usdt),
+		"90")), "set threshold")
+	_, err := db.AddUserCurrency(ctx, userId, rubTinkoff)
+	requireNoError(t, err, "add user currency")
+
+	requireNoError(t, db.DeleteCurrency(ctx, rubTinkoff), "delete currency")
+	err = db.DeleteCurrency(ctx, rubTinkoff)
+	requireError(t, err, core.ErrorCurrencyNotFound, "delete deleted currency")
+
+	converterPairs, err := db.GetConverterPairs(ctx)
+	requireNoError(t, err, "get converter pairs")
+	requireConverterPairs(t, converterPairs, pair(usdt, kztKaspi))
+
+	catalog, err := db.GetCatalogConverterPairs(ctx)
+	requireNoError(t, err, "get catalog converter pairs")
+	if len(catalog) != 1 {
+		t.Fatalf("got catalog pairs %v after delete of currency", catalog)
+	}
+
+	converterPairs, err = db.GetUserConverterPairs(ctx, userId)
+	requireNoError(t, err, "get user converter pairs")
+	requireConverterPairs(t, converterPairs)
+
+	thresholds, err := db.GetAllThresholdConvertPairs(ctx)
+	requireNoError(t, err, "get thresholds")
+	if len(thresholds) != 0 {
+		t.Fatalf("got thresholds %v after delete of currency", thresholds)
+	}
+
+	currencies, err := db.GetUserCurrencies(ctx, userId, nil)
+	requireNoError(t, err, "get user currencies")
+	if len(currencies) != 0 {
+		t.Fatalf("got user currencies %v after delete of currency", currencies)
+	}
+}
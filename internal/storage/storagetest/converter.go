@@ -0,0 +1,174 @@
+package storagetest
+
+import (
+	"context"
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"testing"
+)
+
+func testConverterPairs(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	_, err := db.AddConverterPairIfHasNot(ctx, pair(rubTinkoff))
+	requireError(t, err, core.ErrorConverterInvalidConverterPair, "add pair of one currency")
+
+	firstId := addConverterPair(t, db, pair(rubTinkoff, usdt))
+	if id := addConverterPair(t, db, pair(rubTinkoff, usdt)); id != firstId {
+		t.Fatalf("pair is added twice with ids %d and %d", firstId, id)
+	}
+	addConverterPair(t, db, pair(usdt, rubTinkoff))
+	addConverterPair(t, db, pair(rubTinkoff, usdt, kztKaspi))
+
+	converterPairs, err := db.GetConverterPairs(ctx)
+	requireNoError(t, err, "get converter pairs")
+	requireConverterPairs(t, converterPairs, pair(rubTinkoff, usdt), pair(usdt, rubTinkoff),
+		pair(rubTinkoff, usdt, kztKaspi))
+}
+
+func testSubscribeConverterPair(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	otherId := addUser(t, db, 1002)
+
+	_, err := db.SetUserConverterPair(ctx, userId, pair(rubTinkoff, usdt))
+	requireError(t, err, core.ErrorConverterInvalidConverterPair,
+		"subscribe to pair of unknown currencies")
+
+	addConverterPair(t, db, pair(rubTinkoff, usdt))
+	addConverterPair(t, db, pair(usdt, kztKaspi))
+
+	_, err = db.SetUserConverterPair(ctx, userId, pair(usdt, rubTinkoff))
+	requireError(t, err, core.ErrorConverterConverterPairNotFound, "subscribe to unknown pair")
+
+	subscribe(t, db, userId, pair(usdt, kztKaspi))
+	subscribe(t, db, userId, pair(rubTinkoff, usdt))
+	subscribe(t, db, otherId, pair(usdt, kztKaspi))
+
+	_, err = db.SetUserConverterPair(ctx, userId, pair(rubTinkoff, usdt))
+	requireError(t, err, core.ErrorConverterConverterPairAlreadyExists, "subscribe twice")
+
+	converterPairs, err := db.GetUserConverterPairs(ctx, userId)
+	requireNoError(t, err, "get user converter pairs")
+	requireConverterPairs(t, converterPairs, pair(rubTinkoff, usdt), pair(usdt, kztKaspi))
+
+	converterPairs, err = db.GetUserConverterPairs(ctx, otherId)
+	requireNoError(t, err, "get converter pairs of other user")
+	requireConverterPairs(t, converterPairs, pair(usdt, kztKaspi))
+}
+
+func testThresholds(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	otherId := addUser(t, db, 1002)
+	addConverterPair(t, db, pair(rubTinkoff, usdt))
+	addConverterPair(t, db, pair(usdt, kztKaspi))
+
+	err := db.SetThresholdConvertPair(ctx, userId, threshold(pair(rubTinkoff, usdt), "90"))
+	requireError(t, err, core.ErrorConverterConverterPairNotSubscribed,
+		"set threshold of not subscribed pair")
+
+	err = db.SetThresholdConvertPair(ctx, userId, threshold(pair(usdt, rubTinkoff), "90"))
+	requireError(t, err, core.ErrorConverterConverterPairNotFound, "set threshold of unknown pair")
+
+	subscribe(t, db, userId, pair(rubTinkoff, usdt))
+	subscribe(t, db, userId, pair(usdt, kztKaspi))
+	subscribe(t, db, otherId, pair(rubTinkoff, usdt))
+
+	for _, th := range []core.ThresholdConvertPair{
+		threshold(pair(usdt, kztKaspi), "470.25"),
+		threshold(pair(rubTinkoff, usdt), "95"),
+		threshold(pair(rubTinkoff, usdt), "90.5"),
+		// equal exchange is kept once
+		threshold(pair(rubTinkoff, usdt), "90.50"),
+	} {
+		requireNoError(t, db.SetThresholdConvertPair(ctx, userId, th), "set threshold")
+	}
+	requireNoError(t, db.SetThresholdConvertPair(ctx, otherId,
+		threshold(pair(rubTinkoff, usdt), "91")), "set threshold of other user")
+
+	thresholds, err := db.GetThresholdConvertPair(ctx, userId)
+	requireNoError(t, err, "get thresholds")
+	requireThresholds(t, thresholds, threshold(pair(rubTinkoff, usdt), "90.5"),
+		threshold(pair(rubTinkoff, usdt), "95"), threshold(pair(usdt, kztKaspi), "470.25"))
+
+	all, err := db.GetAllThresholdConvertPairs(ctx)
+	requireNoError(t, err, "get thresholds of all users")
+	if len(all) != 4 {
+		t.Fatalf("got %d thresholds of all users, want 4", len(all))
+	}
+	wantUsers := []struct {
+		userId int
+		chatId int64
+	}{{userId, 1001}, {userId, 1001}, {otherId, 1002}, {userId, 1001}}
+	for i, want := range wantUsers {
+		if all[i].UserId != want.userId || all[i].ChatId != want.chatId {
+			t.Fatalf("threshold %d of all users: got user %d chat %d, want user %d chat %d", i,
+				all[i].UserId, all[i].ChatId, want.userId, want.chatId)
+		}
+	}
+
+	requireNoError(t, db.DeleteThresholdConvertPair(ctx, userId, pair(rubTinkoff, usdt)),
+		"delete thresholds")
+	thresholds, err = db.GetThresholdConvertPair(ctx, userId)
+	requireNoError(t, err, "get thresholds after delete")
+	requireThresholds(t, thresholds, threshold(pair(usdt, kztKaspi), "470.25"))
+
+	thresholds, err = db.GetThresholdConvertPair(ctx, otherId)
+	requireNoError(t, err, "get thresholds of other user")
+	requireThresholds(t, thresholds, threshold(pair(rubTinkoff, usdt), "91"))
+
+	err = db.DeleteThresholdConvertPair(ctx, otherId, pair(usdt, kztKaspi))
+	requireError(t, err, core.ErrorConverterConverterPairNotSubscribed,
+		"delete thresholds of not subscribed pair")
+}
+
+func testThresholdsUserWithoutChatId(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	withoutChatId := addUserWithoutChatId(t, db)
+	addConverterPair(t, db, pair(rubTinkoff, usdt))
+	subscribe(t, db, userId, pair(rubTinkoff, usdt))
+	subscribe(t, db, withoutChatId, pair(rubTinkoff, usdt))
+
+	requireNoError(t, db.SetThresholdConvertPair(ctx, withoutChatId,
+		threshold(pair(rubTinkoff, usdt), "90")), "set threshold of user without chat id")
+	requireNoError(t, db.SetThresholdConvertPair(ctx, userId,
+		threshold(pair(rubTinkoff, usdt), "95")), "set threshold")
+
+	all, err := db.GetAllThresholdConvertPairs(ctx)
+	requireNoError(t, err, "get thresholds of all users")
+	if len(all) != 1 || all[0].UserId != userId || all[0].ChatId != 1001 {
+		t.Fatalf("thresholds of all users = %+v, want only threshold of user %d", all, userId)
+	}
+
+	thresholds, err := db.GetThresholdConvertPair(ctx, withoutChatId)
+	requireNoError(t, err, "get thresholds of user without chat id")
+	requireThresholds(t, thresholds, threshold(pair(rubTinkoff, usdt), "90"))
+}
+
+func threshold(converterPair core.ConverterPair, exchange string) core.ThresholdConvertPair {
+	return core.ThresholdConvertPair{
+		ConverterPair: converterPair,
+		Exchange:      decimal.RequireFromString(exchange),
+	}
+}
+
+func requireThresholds(t *testing.T, got []core.ThresholdConvertPair,
+	want ...core.ThresholdConvertPair) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d thresholds %v, want %d", len(got), got, len(want))
+	}
+	for i := range want {
+		if !equalCurrencies(got[i].ConverterPair.Currencies, want[i].ConverterPair.Currencies) ||
+			!got[i].Exchange.Equal(want[i].Exchange) {
+			t.Fatalf("threshold %d: got %v %s, want %v %s", i, got[i].ConverterPair.Currencies,
+				got[i].Exchange, want[i].ConverterPair.Currencies, want[i].Exchange)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package storagetest
+
+import (
+	"context"
+	"github.com/binance-converter/backend/core"
+	"sort"
+	"testing"
+)
+
+func testUserCurrencies(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	otherId := addUser(t, db, 1002)
+
+	_, err := db.AddUserCurrency(ctx, userId, rubTinkoff)
+	requireNoError(t, err, "add classic currency")
+	_, err = db.AddUserCurrency(ctx, userId, usdt)
+	requireNoError(t, err, "add crypto currency")
+	_, err = db.AddUserCurrency(ctx, otherId, kztKaspi)
+	requireNoError(t, err, "add currency of other user")
+
+	_, err = db.AddUserCurrency(ctx, userId, rubTinkoff)
+	requireError(t, err, core.ErrorCurrencyAlreadyHas, "add duplicate currency")
+
+	currencies, err := db.GetUserCurrencies(ctx, userId, nil)
+	requireNoError(t, err, "get currencies")
+	if !equalCurrencies(currencies, []core.FullCurrency{rubTinkoff, usdt}) {
+		t.Fatalf("got currencies %v", currencies)
+	}
+
+	classic := core.CurrencyTypeClassic
+	currencies, err = db.GetUserCurrencies(ctx, userId, &classic)
+	requireNoError(t, err, "get classic currencies")
+	if !equalCurrencies(currencies, []core.FullCurrency{rubTinkoff}) {
+		t.Fatalf("got classic currencies %v", currencies)
+	}
+
+	crypto := core.CurrencyTypeCrypto
+	currencies, err = db.GetUserCurrencies(ctx, otherId, &crypto)
+	requireNoError(t, err, "get crypto currencies of other user")
+	if len(currencies) != 0 {
+		t.Fatalf("got crypto currencies %v of other user", currencies)
+	}
+}
+
+func testDeleteUserCurrency(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	otherId := addUser(t, db, 1002)
+
+	for _, currency := range []core.FullCurrency{rubTinkoff, rubRosBank, usdt} {
+		_, err := db.AddUserCurrency(ctx, userId, currency)
+		requireNoError(t, err, "add currency")
+	}
+	_, err := db.AddUserCurrency(ctx, otherId, rubTinkoff)
+	requireNoError(t, err, "add currency of other user")
+
+	requireNoError(t, db.DeleteUserCurrency(ctx, userId, "RUB"), "delete currency")
+
+	currencies, err := db.GetUserCurrencies(ctx, userId, nil)
+	requireNoError(t, err, "get currencies")
+	if !equalCurrencies(currencies, []core.FullCurrency{usdt}) {
+		t.Fatalf("got currencies %v after delete", currencies)
+	}
+
+	err = db.DeleteUserCurrency(ctx, userId, "RUB")
+	requireError(t, err, core.ErrorCurrencyNotFound, "delete deleted currency")
+
+	currencies, err = db.GetUserCurrencies(ctx, otherId, nil)
+	requireNoError(t, err, "get currencies of other user")
+	if !equalCurrencies(currencies, []core.FullCurrency{rubTinkoff}) {
+		t.Fatalf("currency of other user is deleted, got %v", currencies)
+	}
+}
+
+func testAvailableCurrencies(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	for _, currency := range []core.FullCurrency{rubTinkoff, rubRosBank, kztKaspi, usdt, btc} {
+		_, err := db.AddCurrencyIfHasNot(ctx, currency)
+		requireNoError(t, err, "add currency")
+	}
+
+	classic, err := db.GetAvailableClassicCurrencies(ctx)
+	requireNoError(t, err, "get classic currencies")
+	requireCodes(t, classic, "KZT", "RUB")
+
+	crypto, err := db.GetAvailableCryptoCurrencies(ctx)
+	requireNoError(t, err, "get crypto currencies")
+	requireCodes(t, crypto, "BTC", "USDT")
+
+	banks, err := db.GetAvailableBanks(ctx, "RUB")
+	requireNoError(t, err, "get banks")
+	var bankCodes []core.CurrencyCode
+	for _, bank := range banks {
+		bankCodes = append(bankCodes, core.CurrencyCode(bank))
+	}
+	requireCodes(t, bankCodes, "RosBankNew", "TinkoffNew")
+
+	banks, err = db.GetAvailableBanks(ctx, "EUR")
+	requireNoError(t, err, "get banks of unknown currency")
+	if len(banks) != 0 {
+		t.Fatalf("got banks %v of unknown currency", banks)
+	}
+}
+
+// requireCodes checks codes regardless of their order
+func requireCodes(t *testing.T, got []core.CurrencyCode, want ...core.CurrencyCode) {
+	t.Helper()
+
+	sorted := append([]core.CurrencyCode(nil), got...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	if len(sorted) != len(want) {
+		t.Fatalf("got codes %v, want %v", got, want)
+	}
+	for i := range want {
+		if sorted[i] != want[i] {
+			t.Fatalf("got codes %v, want %v", got, want)
+		}
+	}
+}
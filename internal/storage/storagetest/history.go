@@ -0,0 +1,246 @@
+package storagetest
+
+import (
+	"context"
+	"github.com/binance-converter/backend/core"
+	timeInterval "github.com/go-follow/time-interval"
+	"github.com/shopspring/decimal"
+	"testing"
+	"time"
+)
+
+func testThresholdAlerts(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	addConverterPair(t, db, pair(rubTinkoff, usdt))
+
+	offset, err := db.GetLastThresholdAlertOffset(ctx)
+	requireNoError(t, err, "get last offset of empty alerts")
+	if offset != 0 {
+		t.Fatalf("got last offset %d of empty alerts", offset)
+	}
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	alert := core.ThresholdAlert{
+		UserId:        userId,
+		ConverterPair: pair(rubTinkoff, usdt),
+		OldExchange:   decimal.RequireFromString("89"),
+		NewExchange:   decimal.RequireFromString("91"),
+		Threshold:     decimal.RequireFromString("90"),
+		Direction:     core.AlertDirectionUp,
+		CreatedAt:     createdAt,
+	}
+
+	var offsets []int64
+	for i := 0; i < 3; i++ {
+		offset, err := db.AddThresholdAlert(ctx, alert)
+		requireNoError(t, err, "add threshold alert")
+		if len(offsets) > 0 && offset <= offsets[len(offsets)-1] {
+			t.Fatalf("offsets %v, %d are not increasing", offsets, offset)
+		}
+		offsets = append(offsets, offset)
+	}
+
+	invalid := alert
+	invalid.Direction = 100
+	_, err = db.AddThresholdAlert(ctx, invalid)
+	requireError(t, err, core.ErrorAlertInvalidDirection, "add alert of invalid direction")
+
+	invalid = alert
+	invalid.ConverterPair = pair(usdt, rubTinkoff)
+	_, err = db.AddThresholdAlert(ctx, invalid)
+	requireError(t, err, core.ErrorConverterConverterPairNotFound, "add alert of unknown pair")
+
+	offset, err = db.GetLastThresholdAlertOffset(ctx)
+	requireNoError(t, err, "get last offset")
+	if offset != offsets[2] {
+		t.Fatalf("got last offset %d, want %d", offset, offsets[2])
+	}
+
+	alerts, err := db.GetThresholdAlerts(ctx, offsets[0], 1)
+	requireNoError(t, err, "get threshold alerts")
+	if len(alerts) != 1 {
+		t.Fatalf("got %d alerts, want 1", len(alerts))
+	}
+	got := alerts[0]
+	if got.Offset != offsets[1] || got.UserId != userId || got.ChatId != 1001 ||
+		!equalCurrencies(got.ConverterPair.Currencies, alert.ConverterPair.Currencies) ||
+		!got.OldExchange.Equal(alert.OldExchange) || !got.NewExchange.Equal(alert.NewExchange) ||
+		!got.Threshold.Equal(alert.Threshold) || got.Direction != alert.Direction ||
+		!got.CreatedAt.Equal(createdAt) {
+		t.Fatalf("got alert %+v", got)
+	}
+
+	alerts, err = db.GetThresholdAlerts(ctx, offsets[2], 10)
+	requireNoError(t, err, "get threshold alerts after last offset")
+	if len(alerts) != 0 {
+		t.Fatalf("got alerts %v after last offset", alerts)
+	}
+}
+
+func testExchangeRates(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	addConverterPair(t, db, pair(rubTinkoff, usdt))
+	addConverterPair(t, db, pair(usdt, kztKaspi))
+
+	start := time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC)
+	samples := []struct {
+		offset   time.Duration
+		exchange string
+	}{
+		{0, "90"},
+		{20 * time.Second, "92"},
+		{40 * time.Second, "89"},
+		{70 * time.Second, "91"},
+		{3 * time.Hour, "100"},
+	}
+	for _, sample := range samples {
+		_, err := db.AddExchangeRate(ctx, core.ExchangeRate{
+			ConverterPair: pair(rubTinkoff, usdt),
+			Exchange:      decimal.RequireFromString(sample.exchange),
+			SampledAt:     start.Add(sample.offset),
+			Source:        "collector",
+		})
+		requireNoError(t, err, "add exchange rate")
+	}
+	_, err := db.AddExchangeRate(ctx, core.ExchangeRate{
+		ConverterPair: pair(usdt, kztKaspi),
+		Exchange:      decimal.RequireFromString("470"),
+		SampledAt:     start,
+		Source:        "collector",
+	})
+	requireNoError(t, err, "add exchange rate of other pair")
+
+	_, err = db.AddExchangeRate(ctx, core.ExchangeRate{
+		ConverterPair: pair(usdt, rubTinkoff),
+		Exchange:      decimal.RequireFromString("1"),
+		SampledAt:     start,
+	})
+	requireError(t, err, core.ErrorConverterConverterPairNotFound, "add rate of unknown pair")
+
+	span, err := timeInterval.New(start, start.Add(time.Hour))
+	requireNoError(t, err, "create time interval")
+	interval := core.TimeInterval(span)
+
+	rates, err := db.GetExchangeRates(ctx, pair(rubTinkoff, usdt), interval)
+	requireNoError(t, err, "get exchange rates")
+	if len(rates) != 4 {
+		t.Fatalf("got %d exchange rates, want 4", len(rates))
+	}
+	for i, rate := range rates {
+		if !rate.Exchange.Equal(decimal.RequireFromString(samples[i].exchange)) ||
+			!rate.SampledAt.Equal(start.Add(samples[i].offset)) || rate.Source != "collector" {
+			t.Fatalf("exchange rate %d: got %+v", i, rate)
+		}
+	}
+
+	candles, err := db.GetExchangeRateCandles(ctx, pair(rubTinkoff, usdt), time.Minute, interval)
+	requireNoError(t, err, "get exchange rate candles")
+	if len(candles) != 2 {
+		t.Fatalf("got %d candles, want 2", len(candles))
+	}
+	first := candles[0]
+	if !first.OpenTime.Equal(start) || !first.Open.Equal(decimal.NewFromInt(90)) ||
+		!first.High.Equal(decimal.NewFromInt(92)) || !first.Low.Equal(decimal.NewFromInt(89)) ||
+		!first.Close.Equal(decimal.NewFromInt(89)) || first.SampleCount != 3 {
+		t.Fatalf("got first candle %+v", first)
+	}
+	second := candles[1]
+	if !second.OpenTime.Equal(start.Add(time.Minute)) || second.SampleCount != 1 ||
+		!second.Open.Equal(decimal.NewFromInt(91)) || !second.Close.Equal(decimal.NewFromInt(91)) {
+		t.Fatalf("got second candle %+v", second)
+	}
+
+	_, err = db.GetExchangeRates(ctx, pair(usdt, rubTinkoff), interval)
+	requireError(t, err, core.ErrorConverterConverterPairNotFound, "get rates of unknown pair")
+}
+
+func testArbitrageOpportunities(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	addConverterPair(t, db, pair(rubTinkoff, usdt, rubTinkoff))
+
+	detectedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	opportunity := core.ArbitrageOpportunity{
+		ConverterPair: pair(rubTinkoff, usdt, rubTinkoff),
+		Rate:          decimal.RequireFromString("1.02"),
+		NetRate:       decimal.RequireFromString("1.01"),
+		DetectedAt:    detectedAt,
+	}
+
+	_, err := db.AddArbitrageOpportunity(ctx, core.ArbitrageOpportunity{
+		ConverterPair: pair(rubTinkoff),
+	})
+	requireError(t, err, core.ErrorArbitrageInvalidCycle, "add cycle of one currency")
+	_, err = db.AddArbitrageOpportunity(ctx, core.ArbitrageOpportunity{
+		ConverterPair: pair(rubTinkoff, btc, rubTinkoff),
+	})
+	requireError(t, err, core.ErrorArbitrageInvalidCycle, "add cycle of unknown currency")
+
+	first, err := db.AddArbitrageOpportunity(ctx, opportunity)
+	requireNoError(t, err, "add arbitrage opportunity")
+	second, err := db.AddArbitrageOpportunity(ctx, opportunity)
+	requireNoError(t, err, "add second arbitrage opportunity")
+	if second <= first {
+		t.Fatalf("offsets %d, %d are not increasing", first, second)
+	}
+
+	offset, err := db.GetLastArbitrageOpportunityOffset(ctx)
+	requireNoError(t, err, "get last offset")
+	if offset != second {
+		t.Fatalf("got last offset %d, want %d", offset, second)
+	}
+
+	opportunities, err := db.GetArbitrageOpportunities(ctx, 0, 10)
+	requireNoError(t, err, "get arbitrage opportunities")
+	if len(opportunities) != 2 {
+		t.Fatalf("got %d opportunities, want 2", len(opportunities))
+	}
+	got := opportunities[0]
+	if got.Offset != first ||
+		!equalCurrencies(got.ConverterPair.Currencies, opportunity.ConverterPair.Currencies) ||
+		!got.Rate.Equal(opportunity.Rate) || !got.NetRate.Equal(opportunity.NetRate) ||
+		!got.DetectedAt.Equal(detectedAt) {
+		t.Fatalf("got opportunity %+v", got)
+	}
+
+	opportunities, err = db.GetArbitrageOpportunities(ctx, first, 10)
+	requireNoError(t, err, "get arbitrage opportunities after offset")
+	if len(opportunities) != 1 || opportunities[0].Offset != second {
+		t.Fatalf("got opportunities %+v after offset %d", opportunities, first)
+	}
+}
+
+func testArbitrageSubscribers(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+	otherId := addUser(t, db, 1002)
+	withoutChatId := addUserWithoutChatId(t, db)
+
+	// user without chat id is subscribed but skipped, opportunities can not be delivered to it
+	requireNoError(t, db.SetArbitrageSubscriber(ctx, withoutChatId),
+		"subscribe user without chat id to arbitrage")
+	requireNoError(t, db.SetArbitrageSubscriber(ctx, otherId), "subscribe to arbitrage")
+	requireNoError(t, db.SetArbitrageSubscriber(ctx, userId), "subscribe to arbitrage")
+	requireNoError(t, db.SetArbitrageSubscriber(ctx, userId), "subscribe to arbitrage twice")
+
+	subscribers, err := db.GetArbitrageSubscribers(ctx)
+	requireNoError(t, err, "get arbitrage subscribers")
+	want := []core.ArbitrageSubscriber{{UserId: userId, ChatId: 1001},
+		{UserId: otherId, ChatId: 1002}}
+	if len(subscribers) != len(want) || subscribers[0] != want[0] || subscribers[1] != want[1] {
+		t.Fatalf("got arbitrage subscribers %v, want %v", subscribers, want)
+	}
+
+	requireNoError(t, db.DeleteArbitrageSubscriber(ctx, userId), "unsubscribe from arbitrage")
+	requireNoError(t, db.DeleteArbitrageSubscriber(ctx, userId), "unsubscribe twice")
+
+	subscribers, err = db.GetArbitrageSubscribers(ctx)
+	requireNoError(t, err, "get arbitrage subscribers after unsubscribe")
+	if len(subscribers) != 1 || subscribers[0].UserId != otherId {
+		t.Fatalf("got arbitrage subscribers %v after unsubscribe", subscribers)
+	}
+}
@@ -0,0 +1,192 @@
+// Package storagetest is a conformance suite of user db implementations, every storage backend
+// runs it from its own tests so backends keep the same error semantics
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/binance-converter/backend/internal/service"
+	"testing"
+)
+
+// UserDb is a union of user db interfaces of services
+type UserDb interface {
+	service.AuthDB
+	service.ConverterUserDb
+	service.CurrencyUserDb
+	service.CatalogUserDb
+	service.FeesUserDb
+	service.AlertsUserDb
+	service.ArbitrageUserDb
+	service.ThresholdWatcherUserDb
+	service.ExchangeRateCollectorUserDb
+	service.ExchangePlotUserDb
+	service.CandlesUserDb
+	GetUsers(ctx context.Context) ([]core.User, error)
+	SetAdmin(ctx context.Context, userId int, isAdmin bool) error
+}
+
+// NewUserDb returns empty storage, it is called for every test of the suite
+type NewUserDb func(t *testing.T) UserDb
+
+// Run runs all tests of the suite against storage returned by newUserDb
+func Run(t *testing.T, newUserDb NewUserDb) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, db UserDb)
+	}{
+		{"SignUp", testSignUp},
+		{"DuplicateUser", testDuplicateUser},
+		{"UnknownUser", testUnknownUser},
+		{"Admin", testAdmin},
+		{"UserCurrencies", testUserCurrencies},
+		{"DeleteUserCurrency", testDeleteUserCurrency},
+		{"AvailableCurrencies", testAvailableCurrencies},
+		{"ConverterPairs", testConverterPairs},
+		{"SubscribeConverterPair", testSubscribeConverterPair},
+		{"Thresholds", testThresholds},
+		{"ThresholdsUserWithoutChatId", testThresholdsUserWithoutChatId},
+		{"CatalogCurrencies", testCatalogCurrencies},
+		{"CatalogConverterPairs", testCatalogConverterPairs},
+		{"SubscribeDisabledConverterPair", testSubscribeDisabledConverterPair},
+		{"SubscribeUnknownUser", testSubscribeUnknownUser},
+		{"DeleteCurrency", testDeleteCurrency},
+		{"AdvertiserFilter", testAdvertiserFilter},
+		{"FeeSchedules", testFeeSchedules},
+		{"ThresholdAlerts", testThresholdAlerts},
+		{"ExchangeRates", testExchangeRates},
+		{"ArbitrageOpportunities", testArbitrageOpportunities},
+		{"ArbitrageSubscribers", testArbitrageSubscribers},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			test.test(t, newUserDb(t))
+		})
+	}
+}
+
+var (
+	rubTinkoff = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeClassic,
+		CurrencyCode: "RUB",
+		BankCode:     "TinkoffNew",
+	}
+	rubRosBank = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeClassic,
+		CurrencyCode: "RUB",
+		BankCode:     "RosBankNew",
+	}
+	kztKaspi = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeClassic,
+		CurrencyCode: "KZT",
+		BankCode:     "KaspiBank",
+	}
+	usdt = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeCrypto,
+		CurrencyCode: "USDT",
+	}
+	btc = core.FullCurrency{
+		CurrencyType: core.CurrencyTypeCrypto,
+		CurrencyCode: "BTC",
+	}
+)
+
+func pair(currencies ...core.FullCurrency) core.ConverterPair {
+	return core.ConverterPair{Currencies: currencies}
+}
+
+// addUser signs up the user with the chat id and returns id of the user
+func addUser(t *testing.T, db UserDb, chatId int64) int {
+	t.Helper()
+
+	firstName, lastName := "First", "Last"
+	userId, err := db.AddUser(context.Background(), core.AddUser{
+		ChatId:    &chatId,
+		FirstName: &firstName,
+		LastName:  &lastName,
+	})
+	if err != nil {
+		t.Fatalf("add user with chat id %d: %v", chatId, err)
+	}
+	return userId
+}
+
+// addUserWithoutChatId adds a user signed up without telegram, alerts can not be delivered to it
+func addUserWithoutChatId(t *testing.T, db UserDb) int {
+	t.Helper()
+
+	firstName, lastName := "First", "Last"
+	userId, err := db.AddUser(context.Background(), core.AddUser{
+		FirstName: &firstName,
+		LastName:  &lastName,
+	})
+	if err != nil {
+		t.Fatalf("add user without chat id: %v", err)
+	}
+	return userId
+}
+
+// addConverterPair adds the pair with its currencies and returns id of the pair
+func addConverterPair(t *testing.T, db UserDb, converterPair core.ConverterPair) int {
+	t.Helper()
+
+	converterPairId, err := db.AddConverterPairIfHasNot(context.Background(), converterPair)
+	if err != nil {
+		t.Fatalf("add converter pair %v: %v", converterPair.Currencies, err)
+	}
+	return converterPairId
+}
+
+func subscribe(t *testing.T, db UserDb, userId int, converterPair core.ConverterPair) {
+	t.Helper()
+
+	if _, err := db.SetUserConverterPair(context.Background(), userId,
+		converterPair); err != nil {
+		t.Fatalf("subscribe user %d to %v: %v", userId, converterPair.Currencies, err)
+	}
+}
+
+func requireNoError(t *testing.T, err error, action string) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("%s: %v", action, err)
+	}
+}
+
+func requireError(t *testing.T, err error, want error, action string) {
+	t.Helper()
+
+	if !errors.Is(err, want) {
+		t.Fatalf("%s: got error %v, want %v", action, err, want)
+	}
+}
+
+func equalCurrencies(a, b []core.FullCurrency) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func requireConverterPairs(t *testing.T, got []core.ConverterPair, want ...core.ConverterPair) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d converter pairs %v, want %d", len(got), got, len(want))
+	}
+	for i := range want {
+		if !equalCurrencies(got[i].Currencies, want[i].Currencies) {
+			t.Fatalf("converter pair %d: got %v, want %v", i, got[i].Currencies,
+				want[i].Currencies)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package storagetest
+
+import (
+	"context"
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"testing"
+)
+
+func testAdvertiserFilter(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	userId := addUser(t, db, 1001)
+
+	filter, err := db.GetUserAdvertiserFilter(ctx, userId)
+	requireNoError(t, err, "get default advertiser filter")
+	if !filter.IsEmpty() {
+		t.Fatalf("got default advertiser filter %+v", filter)
+	}
+
+	want := core.AdvertiserFilter{
+		MerchantOnly:        true,
+		MinCompletionRate:   0.95,
+		MinOrderCount:       100,
+		ExcludedAdvertisers: []string{"s1", "s2"},
+	}
+	requireNoError(t, db.SetUserAdvertiserFilter(ctx, userId, want), "set advertiser filter")
+	filter, err = db.GetUserAdvertiserFilter(ctx, userId)
+	requireNoError(t, err, "get advertiser filter")
+	requireAdvertiserFilter(t, filter, want)
+
+	want = core.AdvertiserFilter{MinOrderCount: 10}
+	requireNoError(t, db.SetUserAdvertiserFilter(ctx, userId, want), "replace advertiser filter")
+	filter, err = db.GetUserAdvertiserFilter(ctx, userId)
+	requireNoError(t, err, "get replaced advertiser filter")
+	requireAdvertiserFilter(t, filter, want)
+}
+
+func requireAdvertiserFilter(t *testing.T, got, want core.AdvertiserFilter) {
+	t.Helper()
+
+	equal := got.MerchantOnly == want.MerchantOnly &&
+		got.MinCompletionRate == want.MinCompletionRate &&
+		got.MinOrderCount == want.MinOrderCount &&
+		len(got.ExcludedAdvertisers) == len(want.ExcludedAdvertisers)
+	for i := 0; equal && i < len(want.ExcludedAdvertisers); i++ {
+		equal = got.ExcludedAdvertisers[i] == want.ExcludedAdvertisers[i]
+	}
+	if !equal {
+		t.Fatalf("got advertiser filter %+v, want %+v", got, want)
+	}
+}
+
+func testFeeSchedules(t *testing.T, db UserDb) {
+	ctx := context.Background()
+
+	fee := func(scope core.FeeScope, key, fixed, percent, spread string) core.FeeSchedule {
+		return core.FeeSchedule{
+			Scope:   scope,
+			Key:     key,
+			Fixed:   decimal.RequireFromString(fixed),
+			Percent: decimal.RequireFromString(percent),
+			Spread:  decimal.RequireFromString(spread),
+		}
+	}
+
+	for _, schedule := range []core.FeeSchedule{
+		fee(core.FeeScopeProvider, "binance_p2p", "0", "0.1", "0.25"),
+		fee(core.FeeScopeBank, "TinkoffNew", "30", "0", "0"),
+		fee(core.FeeScopeBank, "KaspiBank", "0", "1", "0"),
+		// schedule of the same scope and key is replaced
+		fee(core.FeeScopeBank, "TinkoffNew", "50", "0.5", "0"),
+	} {
+		requireNoError(t, db.SetFeeSchedule(ctx, schedule), "set fee schedule")
+	}
+
+	err := db.SetFeeSchedule(ctx, fee(100, "key", "0", "0", "0"))
+	requireError(t, err, core.ErrorFeeInvalidScope, "set fee schedule of invalid scope")
+
+	schedules, err := db.GetFeeSchedules(ctx)
+	requireNoError(t, err, "get fee schedules")
+	requireFeeSchedules(t, schedules, fee(core.FeeScopeBank, "KaspiBank", "0", "1", "0"),
+		fee(core.FeeScopeBank, "TinkoffNew", "50", "0.5", "0"),
+		fee(core.FeeScopeProvider, "binance_p2p", "0", "0.1", "0.25"))
+
+	requireNoError(t, db.DeleteFeeSchedule(ctx, core.FeeScopeBank, "KaspiBank"),
+		"delete fee schedule")
+	err = db.DeleteFeeSchedule(ctx, core.FeeScopeBank, "KaspiBank")
+	requireError(t, err, core.ErrorFeeNotFound, "delete deleted fee schedule")
+	err = db.DeleteFeeSchedule(ctx, core.FeeScopeProvider, "TinkoffNew")
+	requireError(t, err, core.ErrorFeeNotFound, "delete fee schedule of other scope")
+
+	schedules, err = db.GetFeeSchedules(ctx)
+	requireNoError(t, err, "get fee schedules after delete")
+	requireFeeSchedules(t, schedules, fee(core.FeeScopeBank, "TinkoffNew", "50", "0.5", "0"),
+		fee(core.FeeScopeProvider, "binance_p2p", "0", "0.1", "0.25"))
+}
+
+func requireFeeSchedules(t *testing.T, got []core.FeeSchedule, want ...core.FeeSchedule) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got fee schedules %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Scope != want[i].Scope || got[i].Key != want[i].Key ||
+			!got[i].Fixed.Equal(want[i].Fixed) || !got[i].Percent.Equal(want[i].Percent) ||
+			!got[i].Spread.Equal(want[i].Spread) {
+			t.Fatalf("fee schedule %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) IsAdmin(ctx context.Context, userId int) (bool, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	row, ok := u.findUser(userId)
+	if !ok {
+		return false, nil
+	}
+	return row.IsAdmin, nil
+}
+
+func (u *UserDb) SetAdmin(ctx context.Context, userId int, isAdmin bool) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	row, ok := u.findUser(userId)
+	if !ok {
+		return core.ErrorAuthServiceUserNotFound
+	}
+	row.IsAdmin = isAdmin
+	return nil
+}
@@ -0,0 +1,37 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) SetUserAdvertiserFilter(ctx context.Context, userId int,
+	filter core.AdvertiserFilter) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if err := u.checkUser(userId); err != nil {
+		return err
+	}
+	u.advertiserFilters[userId] = copyAdvertiserFilter(filter)
+	return nil
+}
+
+// GetUserAdvertiserFilter returns advertiser filter of the user, empty filter is returned when the
+// user has not set it
+func (u *UserDb) GetUserAdvertiserFilter(ctx context.Context, userId int) (core.AdvertiserFilter,
+	error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return copyAdvertiserFilter(u.advertiserFilters[userId]), nil
+}
+
+func copyAdvertiserFilter(filter core.AdvertiserFilter) core.AdvertiserFilter {
+	if len(filter.ExcludedAdvertisers) == 0 {
+		filter.ExcludedAdvertisers = nil
+		return filter
+	}
+	filter.ExcludedAdvertisers = append([]string(nil), filter.ExcludedAdvertisers...)
+	return filter
+}
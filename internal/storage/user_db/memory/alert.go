@@ -0,0 +1,81 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) AddThresholdAlert(ctx context.Context, alert core.ThresholdAlert) (int64, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	converterPairId, err := u.checkConverterPair(alert.ConverterPair)
+	if err != nil {
+		return 0, err
+	}
+
+	switch alert.Direction {
+	case core.AlertDirectionUp, core.AlertDirectionDown:
+	default:
+		return 0, core.ErrorAlertInvalidDirection
+	}
+
+	if err := u.checkUser(alert.UserId); err != nil {
+		return 0, err
+	}
+
+	u.lastThresholdAlertId++
+	alert.Offset = u.lastThresholdAlertId
+	alert.ChatId = 0
+	alert.ConverterPair = core.ConverterPair{}
+	u.thresholdAlerts = append(u.thresholdAlerts, thresholdAlertRow{
+		alert:           alert,
+		converterPairId: converterPairId,
+	})
+	return alert.Offset, nil
+}
+
+func (u *UserDb) GetThresholdAlerts(ctx context.Context, afterOffset int64,
+	limit int) ([]core.ThresholdAlert, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var alerts []core.ThresholdAlert
+	for _, row := range u.thresholdAlerts {
+		if len(alerts) == limit {
+			break
+		}
+		if row.alert.Offset <= afterOffset {
+			continue
+		}
+		user, ok := u.findUser(row.alert.UserId)
+		if !ok {
+			continue
+		}
+		converterPair, err := u.getConverterPairById(row.converterPairId)
+		if err != nil {
+			return nil, err
+		}
+
+		alert := row.alert
+		alert.ConverterPair = converterPair
+		if user.ChatId != nil {
+			alert.ChatId = *user.ChatId
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+func (u *UserDb) GetLastThresholdAlertOffset(ctx context.Context) (int64, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var offset int64
+	for _, row := range u.thresholdAlerts {
+		if row.alert.Offset > offset {
+			offset = row.alert.Offset
+		}
+	}
+	return offset, nil
+}
@@ -0,0 +1,113 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"sort"
+)
+
+func (u *UserDb) AddArbitrageOpportunity(ctx context.Context,
+	opportunity core.ArbitrageOpportunity) (int64, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if len(opportunity.ConverterPair.Currencies) < 2 {
+		return 0, core.ErrorArbitrageInvalidCycle
+	}
+
+	var currencyIds []int
+	for _, currency := range opportunity.ConverterPair.Currencies {
+		currencyId, err := u.checkCurrency(currency)
+		if err != nil {
+			return 0, core.ErrorArbitrageInvalidCycle
+		}
+		currencyIds = append(currencyIds, currencyId)
+	}
+
+	u.lastArbitrageOpportunityId++
+	opportunity.Offset = u.lastArbitrageOpportunityId
+	opportunity.ConverterPair = core.ConverterPair{}
+	u.arbitrageOpportunities = append(u.arbitrageOpportunities, arbitrageOpportunityRow{
+		opportunity: opportunity,
+		currencyIds: currencyIds,
+	})
+	return opportunity.Offset, nil
+}
+
+func (u *UserDb) GetArbitrageOpportunities(ctx context.Context, afterOffset int64,
+	limit int) ([]core.ArbitrageOpportunity, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var opportunities []core.ArbitrageOpportunity
+	for _, row := range u.arbitrageOpportunities {
+		if len(opportunities) == limit {
+			break
+		}
+		if row.opportunity.Offset <= afterOffset {
+			continue
+		}
+		converterPair, err := u.getConverterPairByCurrencyIds(row.currencyIds)
+		if err != nil {
+			return nil, err
+		}
+
+		opportunity := row.opportunity
+		opportunity.ConverterPair = converterPair
+		opportunities = append(opportunities, opportunity)
+	}
+	return opportunities, nil
+}
+
+func (u *UserDb) GetLastArbitrageOpportunityOffset(ctx context.Context) (int64, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var offset int64
+	for _, row := range u.arbitrageOpportunities {
+		if row.opportunity.Offset > offset {
+			offset = row.opportunity.Offset
+		}
+	}
+	return offset, nil
+}
+
+func (u *UserDb) SetArbitrageSubscriber(ctx context.Context, userId int) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if err := u.checkUser(userId); err != nil {
+		return err
+	}
+	u.arbitrageSubscribers[userId] = struct{}{}
+	return nil
+}
+
+func (u *UserDb) DeleteArbitrageSubscriber(ctx context.Context, userId int) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	delete(u.arbitrageSubscribers, userId)
+	return nil
+}
+
+func (u *UserDb) GetArbitrageSubscribers(ctx context.Context) ([]core.ArbitrageSubscriber, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var subscribers []core.ArbitrageSubscriber
+	for userId := range u.arbitrageSubscribers {
+		user, ok := u.findUser(userId)
+		if !ok || user.ChatId == nil {
+			continue
+		}
+		subscribers = append(subscribers, core.ArbitrageSubscriber{
+			UserId: userId,
+			ChatId: *user.ChatId,
+		})
+	}
+	sort.Slice(subscribers, func(i, j int) bool {
+		return subscribers[i].UserId < subscribers[j].UserId
+	})
+	return subscribers, nil
+}
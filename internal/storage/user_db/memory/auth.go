@@ -0,0 +1,101 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) AddUser(ctx context.Context, user core.AddUser) (int, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if user.FirstName == nil || user.LastName == nil {
+		return 0, core.ErrorAuthServiceEmptyInputArg
+	}
+	if user.ChatId != nil {
+		if _, ok := u.findUserByChatId(*user.ChatId); ok {
+			return 0, core.ErrorAuthServiceAuthUserAlreadyExists
+		}
+	}
+
+	u.lastUserId++
+	newUser := core.User{
+		Id:           u.lastUserId,
+		ChatId:       copyInt64(user.ChatId),
+		UserName:     copyString(user.UserName),
+		FirstName:    *user.FirstName,
+		LastName:     *user.LastName,
+		LanguageCode: copyString(user.LanguageCode),
+	}
+	u.users = append(u.users, userRow{User: newUser})
+
+	return newUser.Id, nil
+}
+
+func (u *UserDb) ValidateUser(ctx context.Context, chatId int) (int, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	row, ok := u.findUserByChatId(int64(chatId))
+	if !ok {
+		return 0, core.ErrorAuthServiceUserNotFound
+	}
+	return row.Id, nil
+}
+
+func (u *UserDb) GetUsers(ctx context.Context) ([]core.User, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var users []core.User
+	for _, row := range u.users {
+		user := row.User
+		user.ChatId = copyInt64(row.ChatId)
+		user.UserName = copyString(row.UserName)
+		user.LanguageCode = copyString(row.LanguageCode)
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (u *UserDb) findUserByChatId(chatId int64) (*userRow, bool) {
+	for i := range u.users {
+		if u.users[i].ChatId != nil && *u.users[i].ChatId == chatId {
+			return &u.users[i], true
+		}
+	}
+	return nil, false
+}
+
+func (u *UserDb) findUser(userId int) (*userRow, bool) {
+	for i := range u.users {
+		if u.users[i].Id == userId {
+			return &u.users[i], true
+		}
+	}
+	return nil, false
+}
+
+// checkUser reports unknown users, postgres rejects rows referencing them by foreign keys
+func (u *UserDb) checkUser(userId int) error {
+	if _, ok := u.findUser(userId); !ok {
+		return core.ErrorAuthServiceUserNotFound
+	}
+	return nil
+}
+
+func copyInt64(value *int64) *int64 {
+	if value == nil {
+		return nil
+	}
+	v := *value
+	return &v
+}
+
+func copyString(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	v := *value
+	return &v
+}
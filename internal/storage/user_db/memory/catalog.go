@@ -0,0 +1,189 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) SetCurrencyDisabled(ctx context.Context, currency core.FullCurrency,
+	disabled bool) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if err := validateCurrencyType(currency.CurrencyType); err != nil {
+		return err
+	}
+	row, ok := u.findCurrencyByValue(currency)
+	if !ok {
+		return core.ErrorCurrencyNotFound
+	}
+	row.disabled = disabled
+	return nil
+}
+
+// DeleteCurrency deletes the currency with converter pairs, arbitrage opportunities and
+// subscriptions of users using it
+func (u *UserDb) DeleteCurrency(ctx context.Context, currency core.FullCurrency) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	currencyId, err := u.checkCurrency(currency)
+	if err != nil {
+		return err
+	}
+
+	converterPairIds := make(map[int]bool)
+	for _, row := range u.converterPairs {
+		if containsId(row.currencyIds, currencyId) {
+			converterPairIds[row.id] = true
+		}
+	}
+	u.deleteConverterPairs(converterPairIds)
+
+	userCurrencies := u.userCurrencies[:0]
+	for _, row := range u.userCurrencies {
+		if row.currencyId != currencyId {
+			userCurrencies = append(userCurrencies, row)
+		}
+	}
+	u.userCurrencies = userCurrencies
+
+	opportunities := u.arbitrageOpportunities[:0]
+	for _, row := range u.arbitrageOpportunities {
+		if !containsId(row.currencyIds, currencyId) {
+			opportunities = append(opportunities, row)
+		}
+	}
+	u.arbitrageOpportunities = opportunities
+
+	currencies := u.currencies[:0]
+	for _, row := range u.currencies {
+		if row.id != currencyId {
+			currencies = append(currencies, row)
+		}
+	}
+	u.currencies = currencies
+	return nil
+}
+
+// GetCatalogCurrencies returns all currencies including disabled ones
+func (u *UserDb) GetCatalogCurrencies(ctx context.Context) ([]core.CatalogCurrency, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var currencies []core.CatalogCurrency
+	for _, row := range u.currencies {
+		currencies = append(currencies, core.CatalogCurrency{
+			Currency: row.currency,
+			Disabled: row.disabled,
+		})
+	}
+	return currencies, nil
+}
+
+func (u *UserDb) SetConverterPairDisabled(ctx context.Context, converterPair core.ConverterPair,
+	disabled bool) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	converterPairId, err := u.checkConverterPair(converterPair)
+	if err != nil {
+		return err
+	}
+	row, _ := u.findConverterPair(converterPairId)
+	row.disabled = disabled
+	return nil
+}
+
+// DeleteConverterPair deletes the converter pair with subscriptions of users to it
+func (u *UserDb) DeleteConverterPair(ctx context.Context, converterPair core.ConverterPair) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	converterPairId, err := u.checkConverterPair(converterPair)
+	if err != nil {
+		return err
+	}
+	u.deleteConverterPairs(map[int]bool{converterPairId: true})
+	return nil
+}
+
+// GetCatalogConverterPairs returns all converter pairs including disabled ones
+func (u *UserDb) GetCatalogConverterPairs(ctx context.Context) ([]core.CatalogConverterPair,
+	error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var catalog []core.CatalogConverterPair
+	for _, row := range u.converterPairs {
+		converterPair, err := u.getConverterPairByCurrencyIds(row.currencyIds)
+		if err != nil {
+			return nil, err
+		}
+		catalog = append(catalog, core.CatalogConverterPair{
+			ConverterPair: converterPair,
+			Disabled:      row.disabled,
+		})
+	}
+	return catalog, nil
+}
+
+// deleteConverterPairs deletes converter pairs with rows referencing them like postgres does by
+// cascade of foreign keys
+func (u *UserDb) deleteConverterPairs(converterPairIds map[int]bool) {
+	if len(converterPairIds) == 0 {
+		return
+	}
+
+	converterPairs := u.converterPairs[:0]
+	for _, row := range u.converterPairs {
+		if !converterPairIds[row.id] {
+			converterPairs = append(converterPairs, row)
+		}
+	}
+	u.converterPairs = converterPairs
+
+	userConverterPairIds := make(map[int]bool)
+	userConverterPairs := u.userConverterPairs[:0]
+	for _, row := range u.userConverterPairs {
+		if converterPairIds[row.converterPairId] {
+			userConverterPairIds[row.id] = true
+			continue
+		}
+		userConverterPairs = append(userConverterPairs, row)
+	}
+	u.userConverterPairs = userConverterPairs
+
+	thresholds := u.thresholds[:0]
+	for _, row := range u.thresholds {
+		if !userConverterPairIds[row.userConverterPairId] {
+			thresholds = append(thresholds, row)
+		}
+	}
+	u.thresholds = thresholds
+
+	alerts := u.thresholdAlerts[:0]
+	for _, row := range u.thresholdAlerts {
+		if !converterPairIds[row.converterPairId] {
+			alerts = append(alerts, row)
+		}
+	}
+	u.thresholdAlerts = alerts
+
+	rates := u.exchangeRates[:0]
+	for _, row := range u.exchangeRates {
+		if !converterPairIds[row.converterPairId] {
+			rates = append(rates, row)
+		}
+	}
+	u.exchangeRates = rates
+}
+
+func containsId(ids []int, id int) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,399 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"sort"
+)
+
+func (u *UserDb) AddConverterPair(ctx context.Context, converterPair core.ConverterPair) (int,
+	error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.addConverterPair(converterPair)
+}
+
+func (u *UserDb) CheckConverterPair(ctx context.Context, converterPair core.ConverterPair) (int,
+	error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return u.checkConverterPair(converterPair)
+}
+
+// GetConverterPairs returns pairs which are enabled and consist of enabled currencies
+func (u *UserDb) GetConverterPairs(ctx context.Context) ([]core.ConverterPair, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var converterPairs []core.ConverterPair
+	for _, row := range u.converterPairs {
+		if !u.isConverterPairAvailable(row) {
+			continue
+		}
+		converterPair, err := u.getConverterPairByCurrencyIds(row.currencyIds)
+		if err != nil {
+			return nil, err
+		}
+		converterPairs = append(converterPairs, converterPair)
+	}
+	return converterPairs, nil
+}
+
+func (u *UserDb) AddConverterPairIfHasNot(ctx context.Context,
+	converterPair core.ConverterPair) (int, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	id, err := u.checkConverterPair(converterPair)
+	if err != nil {
+		switch err {
+		// unknown currencies of the pair are reported as invalid pair, they are added with the
+		// pair
+		case core.ErrorConverterConverterPairNotFound, core.ErrorConverterInvalidConverterPair:
+			return u.addConverterPair(converterPair)
+		default:
+			return 0, err
+		}
+	}
+	return id, nil
+}
+
+func (u *UserDb) SetUserConverterPair(ctx context.Context, userId int,
+	converterPair core.ConverterPair) (int, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	converterPairId, err := u.checkConverterPair(converterPair)
+	if err != nil {
+		return 0, err
+	}
+	if row, ok := u.findConverterPair(converterPairId); ok && !u.isConverterPairAvailable(*row) {
+		return 0, core.ErrorConverterConverterPairDisabled
+	}
+	if err := u.checkUser(userId); err != nil {
+		return 0, err
+	}
+
+	for _, row := range u.userConverterPairs {
+		if row.userId == userId && row.converterPairId == converterPairId {
+			return 0, core.ErrorConverterConverterPairAlreadyExists
+		}
+	}
+
+	u.lastUserConverterPairId++
+	u.userConverterPairs = append(u.userConverterPairs, userConverterPairRow{
+		id:              u.lastUserConverterPairId,
+		userId:          userId,
+		converterPairId: converterPairId,
+	})
+	return u.lastUserConverterPairId, nil
+}
+
+// GetUserConverterPairs returns available pairs the user is subscribed to in order of ids of pairs
+func (u *UserDb) GetUserConverterPairs(ctx context.Context, userId int) ([]core.ConverterPair,
+	error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var converterPairIds []int
+	for _, row := range u.userConverterPairs {
+		if row.userId != userId {
+			continue
+		}
+		if pair, ok := u.findConverterPair(row.converterPairId); ok &&
+			!u.isConverterPairAvailable(*pair) {
+			continue
+		}
+		converterPairIds = append(converterPairIds, row.converterPairId)
+	}
+	sort.Ints(converterPairIds)
+
+	var converterPairs []core.ConverterPair
+	for _, converterPairId := range converterPairIds {
+		converterPair, err := u.getConverterPairById(converterPairId)
+		if err != nil {
+			return nil, err
+		}
+		converterPairs = append(converterPairs, converterPair)
+	}
+	return converterPairs, nil
+}
+
+func (u *UserDb) SetThresholdConvertPair(ctx context.Context, userId int,
+	threshold core.ThresholdConvertPair) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	userConverterPairId, err := u.getUserConverterPairId(userId, threshold.ConverterPair)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range u.thresholds {
+		if row.userId == userId && row.userConverterPairId == userConverterPairId &&
+			row.exchange.Equal(threshold.Exchange) {
+			return nil
+		}
+	}
+
+	u.thresholds = append(u.thresholds, thresholdRow{
+		userId:              userId,
+		userConverterPairId: userConverterPairId,
+		exchange:            threshold.Exchange,
+	})
+	return nil
+}
+
+func (u *UserDb) DeleteThresholdConvertPair(ctx context.Context, userId int,
+	converterPair core.ConverterPair) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	userConverterPairId, err := u.getUserConverterPairId(userId, converterPair)
+	if err != nil {
+		return err
+	}
+
+	thresholds := u.thresholds[:0]
+	for _, row := range u.thresholds {
+		if row.userId == userId && row.userConverterPairId == userConverterPairId {
+			continue
+		}
+		thresholds = append(thresholds, row)
+	}
+	u.thresholds = thresholds
+	return nil
+}
+
+// GetThresholdConvertPair returns thresholds of the user ordered by converter pair and exchange
+func (u *UserDb) GetThresholdConvertPair(ctx context.Context,
+	userId int) ([]core.ThresholdConvertPair, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	userThresholds, err := u.getUserThresholds(func(row thresholdRow) bool {
+		return row.userId == userId
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var thresholds []core.ThresholdConvertPair
+	for _, userThreshold := range userThresholds {
+		thresholds = append(thresholds, userThreshold.Threshold)
+	}
+	return thresholds, nil
+}
+
+// GetAllThresholdConvertPairs returns thresholds of all users ordered by converter pair, user and
+// exchange, users without chat id are skipped as alerts can not be delivered to them
+func (u *UserDb) GetAllThresholdConvertPairs(ctx context.Context) ([]core.UserThresholdConvertPair,
+	error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return u.getUserThresholds(func(row thresholdRow) bool {
+		user, ok := u.findUser(row.userId)
+		return ok && user.ChatId != nil
+	})
+}
+
+func (u *UserDb) getUserThresholds(match func(row thresholdRow) bool) (
+	[]core.UserThresholdConvertPair, error) {
+	type sortableThreshold struct {
+		converterPairId int
+		threshold       core.UserThresholdConvertPair
+	}
+
+	var sortable []sortableThreshold
+	for _, row := range u.thresholds {
+		if !match(row) {
+			continue
+		}
+		userConverterPair, ok := u.findUserConverterPair(row.userConverterPairId)
+		if !ok {
+			continue
+		}
+		user, ok := u.findUser(row.userId)
+		if !ok {
+			continue
+		}
+		converterPair, err := u.getConverterPairById(userConverterPair.converterPairId)
+		if err != nil {
+			return nil, err
+		}
+
+		threshold := core.UserThresholdConvertPair{
+			UserId: row.userId,
+			Threshold: core.ThresholdConvertPair{
+				ConverterPair: converterPair,
+				Exchange:      row.exchange,
+			},
+		}
+		if user.ChatId != nil {
+			threshold.ChatId = *user.ChatId
+		}
+		sortable = append(sortable, sortableThreshold{
+			converterPairId: userConverterPair.converterPairId,
+			threshold:       threshold,
+		})
+	}
+
+	sort.SliceStable(sortable, func(i, j int) bool {
+		a, b := sortable[i], sortable[j]
+		if a.converterPairId != b.converterPairId {
+			return a.converterPairId < b.converterPairId
+		}
+		if a.threshold.UserId != b.threshold.UserId {
+			return a.threshold.UserId < b.threshold.UserId
+		}
+		return a.threshold.Threshold.Exchange.LessThan(b.threshold.Threshold.Exchange)
+	})
+
+	var thresholds []core.UserThresholdConvertPair
+	for _, s := range sortable {
+		thresholds = append(thresholds, s.threshold)
+	}
+	return thresholds, nil
+}
+
+func (u *UserDb) addConverterPair(converterPair core.ConverterPair) (int, error) {
+	if len(converterPair.Currencies) < 2 {
+		return 0, core.ErrorConverterInvalidConverterPair
+	}
+
+	var currencyIds []int
+	for _, currency := range converterPair.Currencies {
+		currencyId, err := u.addCurrencyIfHasNot(currency)
+		if err != nil {
+			return 0, err
+		}
+		currencyIds = append(currencyIds, currencyId)
+	}
+
+	if _, ok := u.findConverterPairByCurrencyIds(currencyIds); ok {
+		return 0, core.ErrorConverterConverterPairAlreadyExists
+	}
+
+	u.lastConverterPairId++
+	u.converterPairs = append(u.converterPairs, converterPairRow{
+		id:          u.lastConverterPairId,
+		currencyIds: currencyIds,
+	})
+	return u.lastConverterPairId, nil
+}
+
+func (u *UserDb) checkConverterPair(converterPair core.ConverterPair) (int, error) {
+	if len(converterPair.Currencies) < 2 {
+		return 0, core.ErrorConverterInvalidConverterPair
+	}
+
+	var currencyIds []int
+	for _, currency := range converterPair.Currencies {
+		currencyId, err := u.checkCurrency(currency)
+		if err != nil {
+			return 0, core.ErrorConverterInvalidConverterPair
+		}
+		currencyIds = append(currencyIds, currencyId)
+	}
+
+	row, ok := u.findConverterPairByCurrencyIds(currencyIds)
+	if !ok {
+		return 0, core.ErrorConverterConverterPairNotFound
+	}
+	return row.id, nil
+}
+
+func (u *UserDb) getUserConverterPairId(userId int, converterPair core.ConverterPair) (int,
+	error) {
+	converterPairId, err := u.checkConverterPair(converterPair)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, row := range u.userConverterPairs {
+		if row.userId == userId && row.converterPairId == converterPairId {
+			return row.id, nil
+		}
+	}
+	return 0, core.ErrorConverterConverterPairNotSubscribed
+}
+
+func (u *UserDb) getConverterPairById(converterPairId int) (core.ConverterPair, error) {
+	row, ok := u.findConverterPair(converterPairId)
+	if !ok {
+		return core.ConverterPair{}, core.ErrorConverterInvalidConverterPair
+	}
+	return u.getConverterPairByCurrencyIds(row.currencyIds)
+}
+
+func (u *UserDb) getConverterPairByCurrencyIds(currencyIds []int) (core.ConverterPair, error) {
+	if len(currencyIds) < 2 {
+		return core.ConverterPair{}, core.ErrorConverterInvalidConverterPair
+	}
+
+	var converterPair core.ConverterPair
+	for _, currencyId := range currencyIds {
+		currency, err := u.getCurrency(currencyId)
+		if err != nil {
+			return core.ConverterPair{}, err
+		}
+		converterPair.Currencies = append(converterPair.Currencies, *currency)
+	}
+	return converterPair, nil
+}
+
+// isConverterPairAvailable reports whether the pair and all its currencies are enabled
+func (u *UserDb) isConverterPairAvailable(row converterPairRow) bool {
+	return !row.disabled && !u.hasDisabledCurrency(row)
+}
+
+func (u *UserDb) hasDisabledCurrency(row converterPairRow) bool {
+	for _, currencyId := range row.currencyIds {
+		if currency, ok := u.findCurrency(currencyId); ok && currency.disabled {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *UserDb) findConverterPair(converterPairId int) (*converterPairRow, bool) {
+	for i := range u.converterPairs {
+		if u.converterPairs[i].id == converterPairId {
+			return &u.converterPairs[i], true
+		}
+	}
+	return nil, false
+}
+
+func (u *UserDb) findConverterPairByCurrencyIds(currencyIds []int) (*converterPairRow, bool) {
+	for i := range u.converterPairs {
+		if equalIds(u.converterPairs[i].currencyIds, currencyIds) {
+			return &u.converterPairs[i], true
+		}
+	}
+	return nil, false
+}
+
+func (u *UserDb) findUserConverterPair(userConverterPairId int) (*userConverterPairRow, bool) {
+	for i := range u.userConverterPairs {
+		if u.userConverterPairs[i].id == userConverterPairId {
+			return &u.userConverterPairs[i], true
+		}
+	}
+	return nil, false
+}
+
+func equalIds(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,244 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) AddCurrency(ctx context.Context, currency core.FullCurrency) (int, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.addCurrency(currency)
+}
+
+func (u *UserDb) CheckCurrency(ctx context.Context, currency core.FullCurrency) (int, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return u.checkCurrency(currency)
+}
+
+func (u *UserDb) GetCurrency(ctx context.Context, currencyId int) (*core.FullCurrency, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return u.getCurrency(currencyId)
+}
+
+func (u *UserDb) AddCurrencyIfHasNot(ctx context.Context, currency core.FullCurrency) (int, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.addCurrencyIfHasNot(currency)
+}
+
+func (u *UserDb) GetAvailableClassicCurrencies(ctx context.Context) ([]core.CurrencyCode, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var currencies []core.CurrencyCode
+	seen := make(map[core.CurrencyCode]bool)
+	for _, row := range u.currencies {
+		if row.currency.CurrencyType != core.CurrencyTypeClassic || row.disabled ||
+			seen[row.currency.CurrencyCode] {
+			continue
+		}
+		seen[row.currency.CurrencyCode] = true
+		currencies = append(currencies, row.currency.CurrencyCode)
+	}
+	return currencies, nil
+}
+
+func (u *UserDb) GetAvailableBanks(ctx context.Context, currency core.CurrencyCode) ([]core.
+	CurrencyBank, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var banks []core.CurrencyBank
+	seen := make(map[core.CurrencyBank]bool)
+	for _, row := range u.currencies {
+		if row.currency.CurrencyType != core.CurrencyTypeClassic ||
+			row.currency.CurrencyCode != currency || row.disabled ||
+			seen[row.currency.BankCode] {
+			continue
+		}
+		seen[row.currency.BankCode] = true
+		banks = append(banks, row.currency.BankCode)
+	}
+	return banks, nil
+}
+
+func (u *UserDb) GetAvailableCryptoCurrencies(ctx context.Context) ([]core.CurrencyCode, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var currencies []core.CurrencyCode
+	for _, row := range u.currencies {
+		if row.currency.CurrencyType != core.CurrencyTypeCrypto || row.disabled {
+			continue
+		}
+		currencies = append(currencies, row.currency.CurrencyCode)
+	}
+	return currencies, nil
+}
+
+func (u *UserDb) AddUserCurrency(ctx context.Context, userId int,
+	currency core.FullCurrency) (int, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if err := u.checkUser(userId); err != nil {
+		return 0, err
+	}
+
+	currencyId, err := u.addCurrencyIfHasNot(currency)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, row := range u.userCurrencies {
+		if row.userId == userId && row.currencyId == currencyId {
+			return 0, core.ErrorCurrencyAlreadyHas
+		}
+	}
+
+	u.lastUserCurrencyId++
+	u.userCurrencies = append(u.userCurrencies, userCurrencyRow{
+		id:         u.lastUserCurrencyId,
+		userId:     userId,
+		currencyId: currencyId,
+	})
+	return u.lastUserCurrencyId, nil
+}
+
+// GetUserCurrencies returns currencies of the user in order they were added, all types are
+// returned when currencyType is nil
+func (u *UserDb) GetUserCurrencies(ctx context.Context, userId int,
+	currencyType *core.CurrencyType) ([]core.FullCurrency, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	if currencyType != nil {
+		if err := validateCurrencyType(*currencyType); err != nil {
+			return nil, err
+		}
+	}
+
+	var currencies []core.FullCurrency
+	for _, row := range u.userCurrencies {
+		if row.userId != userId {
+			continue
+		}
+		currency, err := u.getCurrency(row.currencyId)
+		if err != nil {
+			return nil, err
+		}
+		if currencyType != nil && currency.CurrencyType != *currencyType {
+			continue
+		}
+		currencies = append(currencies, *currency)
+	}
+	return currencies, nil
+}
+
+// DeleteUserCurrency deletes currencies of the user with the code of all banks
+func (u *UserDb) DeleteUserCurrency(ctx context.Context, userId int,
+	currency core.CurrencyCode) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	deleted := false
+	userCurrencies := u.userCurrencies[:0]
+	for _, row := range u.userCurrencies {
+		if row.userId == userId {
+			if c, ok := u.findCurrency(row.currencyId); ok && c.currency.CurrencyCode == currency {
+				deleted = true
+				continue
+			}
+		}
+		userCurrencies = append(userCurrencies, row)
+	}
+	u.userCurrencies = userCurrencies
+
+	if !deleted {
+		return core.ErrorCurrencyNotFound
+	}
+	return nil
+}
+
+func (u *UserDb) addCurrency(currency core.FullCurrency) (int, error) {
+	if err := validateCurrencyType(currency.CurrencyType); err != nil {
+		return 0, err
+	}
+	if _, ok := u.findCurrencyByValue(currency); ok {
+		return 0, core.ErrorCurrencyAlreadyHas
+	}
+
+	u.lastCurrencyId++
+	u.currencies = append(u.currencies, currencyRow{
+		id:       u.lastCurrencyId,
+		currency: currency,
+	})
+	return u.lastCurrencyId, nil
+}
+
+func (u *UserDb) checkCurrency(currency core.FullCurrency) (int, error) {
+	if err := validateCurrencyType(currency.CurrencyType); err != nil {
+		return 0, err
+	}
+	row, ok := u.findCurrencyByValue(currency)
+	if !ok {
+		return 0, core.ErrorCurrencyNotFound
+	}
+	return row.id, nil
+}
+
+func (u *UserDb) getCurrency(currencyId int) (*core.FullCurrency, error) {
+	row, ok := u.findCurrency(currencyId)
+	if !ok {
+		return nil, core.ErrorCurrencyNotFound
+	}
+	currency := row.currency
+	return &currency, nil
+}
+
+func (u *UserDb) addCurrencyIfHasNot(currency core.FullCurrency) (int, error) {
+	id, err := u.checkCurrency(currency)
+	if err != nil {
+		switch err {
+		case core.ErrorCurrencyNotFound:
+			return u.addCurrency(currency)
+		default:
+			return 0, err
+		}
+	}
+	return id, nil
+}
+
+func (u *UserDb) findCurrency(currencyId int) (*currencyRow, bool) {
+	for i := range u.currencies {
+		if u.currencies[i].id == currencyId {
+			return &u.currencies[i], true
+		}
+	}
+	return nil, false
+}
+
+func (u *UserDb) findCurrencyByValue(currency core.FullCurrency) (*currencyRow, bool) {
+	for i := range u.currencies {
+		if u.currencies[i].currency == currency {
+			return &u.currencies[i], true
+		}
+	}
+	return nil, false
+}
+
+func validateCurrencyType(currencyType core.CurrencyType) error {
+	switch currencyType {
+	case core.CurrencyTypeCrypto, core.CurrencyTypeClassic:
+		return nil
+	default:
+		return core.ErrorCurrencyInvalidCurrencyType
+	}
+}
@@ -0,0 +1,113 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"sort"
+	"time"
+)
+
+func (u *UserDb) AddExchangeRate(ctx context.Context, rate core.ExchangeRate) (int64, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	converterPairId, err := u.checkConverterPair(rate.ConverterPair)
+	if err != nil {
+		return 0, err
+	}
+
+	u.lastExchangeRateId++
+	u.exchangeRates = append(u.exchangeRates, exchangeRateRow{
+		converterPairId: converterPairId,
+		exchange:        rate.Exchange,
+		sampledAt:       rate.SampledAt,
+		source:          rate.Source,
+	})
+	return u.lastExchangeRateId, nil
+}
+
+func (u *UserDb) GetExchangeRates(ctx context.Context, converterPair core.ConverterPair,
+	interval core.TimeInterval) ([]core.ExchangeRate, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	rows, err := u.getExchangeRates(converterPair, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	var rates []core.ExchangeRate
+	for _, row := range rows {
+		rates = append(rates, core.ExchangeRate{
+			ConverterPair: converterPair,
+			Exchange:      row.exchange,
+			SampledAt:     row.sampledAt,
+			Source:        row.source,
+		})
+	}
+	return rates, nil
+}
+
+// GetExchangeRateCandles groups rates of the interval into candles of timeframe aligned to unix
+// epoch
+func (u *UserDb) GetExchangeRateCandles(ctx context.Context, converterPair core.ConverterPair,
+	timeframe time.Duration, interval core.TimeInterval) ([]core.Candle, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	rows, err := u.getExchangeRates(converterPair, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	seconds := int64(timeframe / time.Second)
+	if seconds <= 0 {
+		return nil, core.ErrorCandleInvalidTimeframe
+	}
+
+	var candles []core.Candle
+	for _, row := range rows {
+		openTime := time.Unix(row.sampledAt.Unix()/seconds*seconds, 0)
+		if len(candles) == 0 || !candles[len(candles)-1].OpenTime.Equal(openTime) {
+			candles = append(candles, core.Candle{
+				OpenTime: openTime,
+				Open:     row.exchange,
+				High:     row.exchange,
+				Low:      row.exchange,
+			})
+		}
+		candle := &candles[len(candles)-1]
+		if row.exchange.GreaterThan(candle.High) {
+			candle.High = row.exchange
+		}
+		if row.exchange.LessThan(candle.Low) {
+			candle.Low = row.exchange
+		}
+		candle.Close = row.exchange
+		candle.SampleCount++
+	}
+	return candles, nil
+}
+
+// getExchangeRates returns rates of converter pair sampled in the interval ordered by sample time
+func (u *UserDb) getExchangeRates(converterPair core.ConverterPair,
+	interval core.TimeInterval) ([]exchangeRateRow, error) {
+	converterPairId, err := u.checkConverterPair(converterPair)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := interval.Start(), interval.End()
+	var rows []exchangeRateRow
+	for _, row := range u.exchangeRates {
+		if row.converterPairId != converterPairId || row.sampledAt.Before(start) ||
+			row.sampledAt.After(end) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].sampledAt.Before(rows[j].sampledAt)
+	})
+	return rows, nil
+}
@@ -0,0 +1,67 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"golang.org/x/net/context"
+	"sort"
+)
+
+func (u *UserDb) SetFeeSchedule(ctx context.Context, schedule core.FeeSchedule) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if err := validateFeeScope(schedule.Scope); err != nil {
+		return err
+	}
+
+	for i := range u.feeSchedules {
+		if u.feeSchedules[i].Scope == schedule.Scope && u.feeSchedules[i].Key == schedule.Key {
+			u.feeSchedules[i] = schedule
+			return nil
+		}
+	}
+	u.feeSchedules = append(u.feeSchedules, schedule)
+	return nil
+}
+
+func (u *UserDb) DeleteFeeSchedule(ctx context.Context, scope core.FeeScope, key string) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if err := validateFeeScope(scope); err != nil {
+		return err
+	}
+
+	for i := range u.feeSchedules {
+		if u.feeSchedules[i].Scope == scope && u.feeSchedules[i].Key == key {
+			u.feeSchedules = append(u.feeSchedules[:i], u.feeSchedules[i+1:]...)
+			return nil
+		}
+	}
+	return core.ErrorFeeNotFound
+}
+
+// GetFeeSchedules returns fee schedules ordered by scope and key
+func (u *UserDb) GetFeeSchedules(ctx context.Context) ([]core.FeeSchedule, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	var schedules []core.FeeSchedule
+	schedules = append(schedules, u.feeSchedules...)
+	sort.Slice(schedules, func(i, j int) bool {
+		if schedules[i].Scope != schedules[j].Scope {
+			return schedules[i].Scope < schedules[j].Scope
+		}
+		return schedules[i].Key < schedules[j].Key
+	})
+	return schedules, nil
+}
+
+func validateFeeScope(scope core.FeeScope) error {
+	switch scope {
+	case core.FeeScopeBank, core.FeeScopeProvider:
+		return nil
+	default:
+		return core.ErrorFeeInvalidScope
+	}
+}
@@ -0,0 +1,93 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/core"
+	"sync"
+	"time"
+)
+
+// UserDb keeps users, currencies and converter pairs in memory, it is used for tests and local
+// development. Rows are kept in order of their ids like serial ids of postgres
+type UserDb struct {
+	mu sync.RWMutex
+
+	users                  []userRow
+	currencies             []currencyRow
+	converterPairs         []converterPairRow
+	userCurrencies         []userCurrencyRow
+	userConverterPairs     []userConverterPairRow
+	thresholds             []thresholdRow
+	thresholdAlerts        []thresholdAlertRow
+	exchangeRates          []exchangeRateRow
+	arbitrageOpportunities []arbitrageOpportunityRow
+	arbitrageSubscribers   map[int]struct{}
+	feeSchedules           []core.FeeSchedule
+	advertiserFilters      map[int]core.AdvertiserFilter
+
+	lastUserId                 int
+	lastCurrencyId             int
+	lastConverterPairId        int
+	lastUserCurrencyId         int
+	lastUserConverterPairId    int
+	lastThresholdAlertId       int64
+	lastExchangeRateId         int64
+	lastArbitrageOpportunityId int64
+}
+
+type userRow struct {
+	core.User
+}
+
+type currencyRow struct {
+	id       int
+	currency core.FullCurrency
+	disabled bool
+}
+
+type converterPairRow struct {
+	id          int
+	currencyIds []int
+	disabled    bool
+}
+
+type userCurrencyRow struct {
+	id         int
+	userId     int
+	currencyId int
+}
+
+type userConverterPairRow struct {
+	id              int
+	userId          int
+	converterPairId int
+}
+
+type thresholdRow struct {
+	userId              int
+	userConverterPairId int
+	exchange            core.Exchange
+}
+
+type thresholdAlertRow struct {
+	alert           core.ThresholdAlert
+	converterPairId int
+}
+
+type exchangeRateRow struct {
+	converterPairId int
+	exchange        core.Exchange
+	sampledAt       time.Time
+	source          string
+}
+
+type arbitrageOpportunityRow struct {
+	opportunity core.ArbitrageOpportunity
+	currencyIds []int
+}
+
+func NewUserDB() *UserDb {
+	return &UserDb{
+		arbitrageSubscribers: make(map[int]struct{}),
+		advertiserFilters:    make(map[int]core.AdvertiserFilter),
+	}
+}
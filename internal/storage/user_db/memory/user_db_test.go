@@ -0,0 +1,12 @@
+package userDbMemory
+
+import (
+	"github.com/binance-converter/backend/internal/storage/storagetest"
+	"testing"
+)
+
+func TestUserDbConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.UserDb {
+		return NewUserDB()
+	})
+}
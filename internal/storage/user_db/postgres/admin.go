@@ -0,0 +1,68 @@
+package userDbPostgres
+
+import (
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/jackc/pgx/v4"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) IsAdmin(ctx context.Context, userId int) (bool, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					is_admin
+				FROM
+					users
+				WHERE
+					id = $1`
+
+	var isAdmin bool
+	if err := db.QueryRow(ctx, query, userId).Scan(&isAdmin); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error check admin role of user")
+		return false, err
+	}
+	return isAdmin, nil
+}
+
+func (u *UserDb) SetAdmin(ctx context.Context, userId int, isAdmin bool) error {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	UPDATE
+					users
+				SET
+					is_admin = $2
+				WHERE
+					id = $1`
+
+	result, err := db.Exec(ctx, query, userId, isAdmin)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":   logQuery(query),
+			"userId":  userId,
+			"isAdmin": isAdmin,
+			"error":   err,
+		}).Error("error set admin role of user")
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return core.ErrorAuthServiceUserNotFound
+	}
+	return nil
+}
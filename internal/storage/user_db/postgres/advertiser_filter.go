@@ -0,0 +1,90 @@
+package userDbPostgres
+
+import (
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/jackc/pgx/v4"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) SetUserAdvertiserFilter(ctx context.Context, userId int,
+	filter core.AdvertiserFilter) error {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	excluded := filter.ExcludedAdvertisers
+	if excluded == nil {
+		excluded = []string{}
+	}
+
+	query := `	INSERT INTO
+					user_advertiser_filters
+					(user_id, merchant_only, min_completion_rate, min_order_count,
+					 excluded_advertisers)
+				VALUES
+					($1, $2, $3, $4, $5)
+				ON CONFLICT
+					(user_id)
+				DO UPDATE SET
+					merchant_only = excluded.merchant_only,
+					min_completion_rate = excluded.min_completion_rate,
+					min_order_count = excluded.min_order_count,
+					excluded_advertisers = excluded.excluded_advertisers,
+					updated_at = now()`
+
+	if _, err := db.Exec(ctx, query, userId, filter.MerchantOnly, filter.MinCompletionRate,
+		filter.MinOrderCount, excluded); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"filter": filter,
+			"error":  err,
+		}).Error("error set advertiser filter of user")
+		return err
+	}
+	return nil
+}
+
+// GetUserAdvertiserFilter returns advertiser filter of the user, empty filter is returned when the
+// user has not set it
+func (u *UserDb) GetUserAdvertiserFilter(ctx context.Context, userId int) (core.AdvertiserFilter,
+	error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					merchant_only,
+					min_completion_rate,
+					min_order_count,
+					excluded_advertisers
+				FROM
+					user_advertiser_filters
+				WHERE
+					user_id = $1`
+
+	var filter core.AdvertiserFilter
+	if err := db.QueryRow(ctx, query, userId).Scan(&filter.MerchantOnly,
+		&filter.MinCompletionRate, &filter.MinOrderCount,
+		&filter.ExcludedAdvertisers); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return core.AdvertiserFilter{}, nil
+		}
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error get advertiser filter of user")
+		return core.AdvertiserFilter{}, err
+	}
+	if len(filter.ExcludedAdvertisers) == 0 {
+		filter.ExcludedAdvertisers = nil
+	}
+	return filter, nil
+}
@@ -0,0 +1,193 @@
+package userDbPostgres
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (u *UserDb) AddThresholdAlert(ctx context.Context, alert core.ThresholdAlert) (int64, error) {
+	converterPairId, err := u.CheckConverterPair(ctx, alert.ConverterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": alert.ConverterPair,
+			"error":         err.Error(),
+		}).Error("error check converter pair")
+		return 0, err
+	}
+
+	direction, err := u.convertCoreAlertDirectionToPostgres(alert.Direction)
+	if err != nil {
+		return 0, err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	INSERT INTO
+					threshold_alerts
+					(user_id, converter_pair_id, old_exchange, new_exchange, threshold, direction,
+					 created_at)
+				VALUES
+					($1, $2, $3, $4, $5, $6, $7)
+				RETURNING
+					id`
+
+	var offset int64
+	err = db.QueryRow(ctx, query, alert.UserId, converterPairId, formatExchange(alert.OldExchange),
+		formatExchange(alert.NewExchange), formatExchange(alert.Threshold), direction,
+		alert.CreatedAt).Scan(&offset)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"alert": alert,
+			"error": err,
+		}).Error("error add threshold alert")
+		return 0, err
+	}
+
+	return offset, nil
+}
+
+func (u *UserDb) GetThresholdAlerts(ctx context.Context, afterOffset int64,
+	limit int) ([]core.ThresholdAlert, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					a.id, a.user_id, u.chat_id, a.converter_pair_id,
+					a.old_exchange, a.new_exchange, a.threshold, a.direction, a.created_at
+				FROM
+					threshold_alerts a
+					JOIN users u ON u.id = a.user_id
+				WHERE
+					a.id > $1
+				ORDER BY
+					a.id
+				LIMIT $2`
+
+	rows, err := db.Query(ctx, query, afterOffset, limit)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":       logQuery(query),
+			"afterOffset": afterOffset,
+			"error":       err,
+		}).Error("error run query when get threshold alerts")
+		return nil, err
+	}
+
+	type alertRow struct {
+		offset                   int64
+		userId                   int
+		chatId                   int64
+		converterPairId          int
+		oldExchange, newExchange core.Exchange
+		threshold                core.Exchange
+		direction                string
+		createdAt                time.Time
+	}
+
+	var alertRows []alertRow
+	for rows.Next() {
+		var row alertRow
+		if err := rows.Scan(&row.offset, &row.userId, &row.chatId, &row.converterPairId,
+			&row.oldExchange, &row.newExchange, &row.threshold, &row.direction,
+			&row.createdAt); err != nil {
+			rows.Close()
+			logrus.WithFields(logrus.Fields{
+				"query":       logQuery(query),
+				"afterOffset": afterOffset,
+				"error":       err,
+			}).Error("error scan row when get threshold alerts")
+			return nil, err
+		}
+		alertRows = append(alertRows, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var alerts []core.ThresholdAlert
+	for _, row := range alertRows {
+		converterPair, err := u.getConverterPairById(ctx, row.converterPairId)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"offset": row.offset,
+				"error":  err,
+			}).Error("error get converter pair when get threshold alerts")
+			return nil, err
+		}
+		direction, err := u.convertPostgresAlertDirectionToCore(row.direction)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, core.ThresholdAlert{
+			Offset:        row.offset,
+			UserId:        row.userId,
+			ChatId:        row.chatId,
+			ConverterPair: converterPair,
+			OldExchange:   row.oldExchange,
+			NewExchange:   row.newExchange,
+			Threshold:     row.threshold,
+			Direction:     direction,
+			CreatedAt:     row.createdAt,
+		})
+	}
+
+	return alerts, nil
+}
+
+func (u *UserDb) GetLastThresholdAlertOffset(ctx context.Context) (int64, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					COALESCE(MAX(id), 0)
+				FROM
+					threshold_alerts`
+
+	var offset int64
+	if err := db.QueryRow(ctx, query).Scan(&offset); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error get last threshold alert offset")
+		return 0, err
+	}
+	return offset, nil
+}
+
+func (u *UserDb) convertCoreAlertDirectionToPostgres(direction core.AlertDirection) (string,
+	error) {
+	switch direction {
+	case core.AlertDirectionUp:
+		return "up", nil
+	case core.AlertDirectionDown:
+		return "down", nil
+	default:
+		return "", core.ErrorAlertInvalidDirection
+	}
+}
+
+func (u *UserDb) convertPostgresAlertDirectionToCore(direction string) (core.AlertDirection,
+	error) {
+	switch direction {
+	case "up":
+		return core.AlertDirectionUp, nil
+	case "down":
+		return core.AlertDirectionDown, nil
+	default:
+		return 0, core.ErrorAlertInvalidDirection
+	}
+}
@@ -0,0 +1,258 @@
+package userDbPostgres
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (u *UserDb) AddArbitrageOpportunity(ctx context.Context,
+	opportunity core.ArbitrageOpportunity) (int64, error) {
+	if len(opportunity.ConverterPair.Currencies) < 2 {
+		return 0, core.ErrorArbitrageInvalidCycle
+	}
+
+	var currencyIds []int
+	for _, currency := range opportunity.ConverterPair.Currencies {
+		currencyId, err := u.CheckCurrency(ctx, currency)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"currency": currency,
+				"error":    err,
+			}).Error("error check currency of arbitrage opportunity")
+			return 0, core.ErrorArbitrageInvalidCycle
+		}
+		currencyIds = append(currencyIds, currencyId)
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	WITH opportunity AS (
+					INSERT INTO
+						arbitrage_opportunities
+						(rate, net_rate, detected_at)
+					VALUES
+						($1, $2, $3)
+					RETURNING
+						id
+				)
+				INSERT INTO
+					arbitrage_opportunity_steps
+					(arbitrage_opportunity_id, position, currency_id)
+				SELECT
+					opportunity.id, step.position - 1, step.currency_id
+				FROM
+					opportunity,
+					unnest($4::int[]) WITH ORDINALITY AS step(currency_id, position)
+				RETURNING
+					arbitrage_opportunity_id`
+
+	var offset int64
+	err := db.QueryRow(ctx, query, opportunity.Rate.String(), opportunity.NetRate.String(),
+		opportunity.DetectedAt, currencyIds).Scan(&offset)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":       logQuery(query),
+			"opportunity": opportunity,
+			"error":       err,
+		}).Error("error add arbitrage opportunity")
+		return 0, err
+	}
+
+	return offset, nil
+}
+
+func (u *UserDb) GetArbitrageOpportunities(ctx context.Context, afterOffset int64,
+	limit int) ([]core.ArbitrageOpportunity, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					id, rate, net_rate, detected_at
+				FROM
+					arbitrage_opportunities
+				WHERE
+					id > $1
+				ORDER BY
+					id
+				LIMIT $2`
+
+	rows, err := db.Query(ctx, query, afterOffset, limit)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":       logQuery(query),
+			"afterOffset": afterOffset,
+			"error":       err,
+		}).Error("error run query when get arbitrage opportunities")
+		return nil, err
+	}
+
+	var opportunities []core.ArbitrageOpportunity
+	for rows.Next() {
+		var opportunity core.ArbitrageOpportunity
+		var detectedAt time.Time
+		if err := rows.Scan(&opportunity.Offset, &opportunity.Rate, &opportunity.NetRate,
+			&detectedAt); err != nil {
+			rows.Close()
+			logrus.WithFields(logrus.Fields{
+				"query":       logQuery(query),
+				"afterOffset": afterOffset,
+				"error":       err,
+			}).Error("error scan row when get arbitrage opportunities")
+			return nil, err
+		}
+		opportunity.DetectedAt = detectedAt
+		opportunities = append(opportunities, opportunity)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	stepsQuery := `	SELECT
+						currency_id
+					FROM
+						arbitrage_opportunity_steps
+					WHERE
+						arbitrage_opportunity_id = $1
+					ORDER BY
+						position`
+
+	for i := range opportunities {
+		converterPair, err := u.getConverterPairBySteps(ctx, stepsQuery, opportunities[i].Offset)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"offset": opportunities[i].Offset,
+				"error":  err,
+			}).Error("error get cycle when get arbitrage opportunities")
+			return nil, err
+		}
+		opportunities[i].ConverterPair = converterPair
+	}
+
+	return opportunities, nil
+}
+
+func (u *UserDb) GetLastArbitrageOpportunityOffset(ctx context.Context) (int64, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					COALESCE(MAX(id), 0)
+				FROM
+					arbitrage_opportunities`
+
+	var offset int64
+	if err := db.QueryRow(ctx, query).Scan(&offset); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error get last arbitrage opportunity offset")
+		return 0, err
+	}
+	return offset, nil
+}
+
+func (u *UserDb) SetArbitrageSubscriber(ctx context.Context, userId int) error {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	INSERT INTO
+					arbitrage_subscribers
+					(user_id)
+				VALUES
+					($1)
+				ON CONFLICT
+					(user_id)
+				DO NOTHING`
+
+	if _, err := db.Exec(ctx, query, userId); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error set arbitrage subscriber")
+		return err
+	}
+	return nil
+}
+
+func (u *UserDb) DeleteArbitrageSubscriber(ctx context.Context, userId int) error {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	DELETE FROM
+					arbitrage_subscribers
+				WHERE
+					user_id = $1`
+
+	if _, err := db.Exec(ctx, query, userId); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error delete arbitrage subscriber")
+		return err
+	}
+	return nil
+}
+
+func (u *UserDb) GetArbitrageSubscribers(ctx context.Context) ([]core.ArbitrageSubscriber, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					u.id, u.chat_id
+				FROM
+					arbitrage_subscribers s
+					JOIN users u ON u.id = s.user_id
+				WHERE
+					u.chat_id IS NOT NULL
+				ORDER BY
+					u.id`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error run query when get arbitrage subscribers")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscribers []core.ArbitrageSubscriber
+	for rows.Next() {
+		var subscriber core.ArbitrageSubscriber
+		if err := rows.Scan(&subscriber.UserId, &subscriber.ChatId); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query": logQuery(query),
+				"error": err,
+			}).Error("error scan row when get arbitrage subscribers")
+			return nil, err
+		}
+		subscribers = append(subscribers, subscriber)
+	}
+
+	return subscribers, rows.Err()
+}
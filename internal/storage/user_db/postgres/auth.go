@@ -1,8 +1,10 @@
 package userDbPostgres
 
 import (
+	"errors"
 	"github.com/binance-converter/backend/core"
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
@@ -80,16 +82,56 @@ func (u *UserDb) ValidateUser(ctx context.Context, chatId int) (int, error) {
 
 	var userId int
 	if err := row.Scan(&userId); err != nil {
-		if pgErr, ok := err.(*pgconn.PgError); ok {
-			switch pgErr.Code {
-			case "no rows in result set":
-				return 0, core.ErrorAuthServiceUserNotFound
-			default:
-				return 0, err
-			}
-		} else {
-			return 0, err
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, core.ErrorAuthServiceUserNotFound
 		}
+		return 0, err
 	}
 	return userId, nil
 }
+
+func (u *UserDb) GetUsers(ctx context.Context) ([]core.User, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					id,
+					chat_id,
+					user_name,
+					first_name,
+					last_name,
+					language_code,
+					is_admin
+				FROM
+					users
+				ORDER BY
+					id`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error get users")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []core.User
+	for rows.Next() {
+		var user core.User
+		if err := rows.Scan(&user.Id, &user.ChatId, &user.UserName, &user.FirstName,
+			&user.LastName, &user.LanguageCode, &user.IsAdmin); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query": logQuery(query),
+				"error": err,
+			}).Error("error scan user")
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
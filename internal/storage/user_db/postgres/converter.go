@@ -1,16 +1,20 @@
 package userDbPostgres
 
 import (
+	"errors"
 	"github.com/binance-converter/backend/core"
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"strconv"
+	"strings"
 )
 
 func (u *UserDb) AddConverterPair(ctx context.Context, converterPair core.ConverterPair) (int,
 	error) {
 
-	if len(converterPair.Currencies) != 2 && len(converterPair.Currencies) != 3 {
+	if len(converterPair.Currencies) < 2 {
 		return 0, core.ErrorConverterInvalidConverterPair
 	}
 
@@ -20,29 +24,37 @@ func (u *UserDb) AddConverterPair(ctx context.Context, converterPair core.Conver
 		db = tx
 	}
 
-	query := `	INSERT INTO
-        			converter_pairs 
-	    			(level, first_currency_id, second_currency_id, third_currency_id)
-        		VALUES 
-            		($1, $2, $3, $4)
-				RETURNING 
-					id`
+	query := `	WITH pair AS (
+					INSERT INTO
+						converter_pairs
+						(level, route)
+					VALUES
+						($1, $2)
+					RETURNING
+						id
+				)
+				INSERT INTO
+					converter_pair_steps
+					(converter_pair_id, position, currency_id)
+				SELECT
+					pair.id, step.position - 1, step.currency_id
+				FROM
+					pair,
+					unnest($3::int[]) WITH ORDINALITY AS step(currency_id, position)
+				RETURNING
+					converter_pair_id`
 
-	var additionalArgs []interface{}
+	var currencyIds []int
 
 	for _, currency := range converterPair.Currencies {
 		currencyId, err := u.AddCurrencyIfHasNot(ctx, currency)
 		if err != nil {
 			return 0, err
 		}
-		additionalArgs = append(additionalArgs, currencyId)
+		currencyIds = append(currencyIds, currencyId)
 	}
 
-	if len(additionalArgs) == 2 {
-		additionalArgs = append(additionalArgs, nil)
-	}
-
-	row := db.QueryRow(ctx, query, len(additionalArgs), additionalArgs)
+	row := db.QueryRow(ctx, query, len(currencyIds), converterPairRoute(currencyIds), currencyIds)
 
 	var converterPairId int
 	if err := row.Scan(&converterPairId); err != nil {
@@ -64,7 +76,7 @@ func (u *UserDb) AddConverterPair(ctx context.Context, converterPair core.Conver
 func (u *UserDb) CheckConverterPair(ctx context.Context, converterPair core.ConverterPair) (int,
 	error) {
 
-	if len(converterPair.Currencies) != 2 && len(converterPair.Currencies) != 3 {
+	if len(converterPair.Currencies) < 2 {
 		return 0, core.ErrorConverterInvalidConverterPair
 	}
 
@@ -78,16 +90,9 @@ func (u *UserDb) CheckConverterPair(ctx context.Context, converterPair core.Conv
                 FROM
                     converter_pairs
                 WHERE
-                    level = $1 AND
-                    first_currency_id = $2 AND
-                    second_currency_id = $3`
-
-	if len(converterPair.Currencies) == 3 {
-		query += "AND third_currency_id = $4"
-	}
+                    route = $1`
 
-	var additionalArgs []interface{}
-	additionalArgs = append(additionalArgs, len(converterPair.Currencies))
+	var currencyIds []int
 	for _, currency := range converterPair.Currencies {
 		currencyId, err := u.CheckCurrency(ctx, currency)
 		if err != nil {
@@ -97,34 +102,41 @@ func (u *UserDb) CheckConverterPair(ctx context.Context, converterPair core.Conv
 			}).Error("error check currency")
 			return 0, core.ErrorConverterInvalidConverterPair
 		}
-		additionalArgs = append(additionalArgs, currencyId)
+		currencyIds = append(currencyIds, currencyId)
 	}
 
-	row := db.QueryRow(ctx, query, additionalArgs...)
+	route := converterPairRoute(currencyIds)
+	row := db.QueryRow(ctx, query, route)
 	var converterPairId int
 	if err := row.Scan(&converterPairId); err != nil {
-		if pgErr, ok := err.(*pgconn.PgError); ok {
-			logrus.WithFields(logrus.Fields{
-				"error":          pgErr,
-				"additionalArgs": additionalArgs,
-			}).Error("error scan converter pair id")
-			switch pgErr.Code {
-			case "no rows in result set":
-				return 0, core.ErrorConverterConverterPairNotFound
-			default:
-				return 0, err
-			}
-		} else {
-			logrus.WithFields(logrus.Fields{
-				"error":          err.Error(),
-				"additionalArgs": additionalArgs,
-			}).Error("error scan converter pair id")
-			return 0, err
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, core.ErrorConverterConverterPairNotFound
 		}
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"route": route,
+		}).Error("error scan converter pair id")
+		return 0, err
 	}
 	return converterPairId, nil
 }
 
+// availableConverterPairCondition selects converter pairs which and all currencies of which are
+// enabled
+const availableConverterPairCondition = `
+					NOT converter_pairs.disabled AND
+					NOT EXISTS (
+						SELECT
+							1
+						FROM
+							converter_pair_steps
+						JOIN
+							currencies ON currencies.id = converter_pair_steps.currency_id
+						WHERE
+							converter_pair_steps.converter_pair_id = converter_pairs.id AND
+							currencies.disabled
+					)`
+
 func (u *UserDb) GetConverterPairs(ctx context.Context) ([]core.ConverterPair, error) {
 	db := u.dbDriver
 	tx, ok := u.transactionDB.ExtractTx(ctx)
@@ -133,9 +145,12 @@ func (u *UserDb) GetConverterPairs(ctx context.Context) ([]core.ConverterPair, e
 	}
 
 	query := `	SELECT
-	    			level, first_currency_id, second_currency_id, third_currency_id
+	    			id
 				FROM
-				    converter_pairs`
+				    converter_pairs
+				WHERE` + availableConverterPairCondition + `
+				ORDER BY
+					id`
 
 	rows, err := db.Query(ctx, query)
 	if err != nil {
@@ -157,66 +172,16 @@ func (u *UserDb) GetConverterPairs(ctx context.Context) ([]core.ConverterPair, e
 		}
 	}
 
-	var converterPairs []core.ConverterPair
-
-	for rows.Next() {
-		var level, firstId, secondId, thirdId *int
-		if err := rows.Scan(&level, &firstId, &secondId, &thirdId); err != nil {
-			if pgErr, ok := err.(*pgconn.PgError); ok {
-				logrus.WithFields(logrus.Fields{
-					"error": pgErr,
-					"query": query,
-				}).Error("error scan row")
-				switch pgErr.Code {
-				default:
-					return nil, err
-				}
-			} else {
-				logrus.WithFields(logrus.Fields{
-					"error": err,
-					"query": query,
-				}).Error("error scan row")
-				return nil, err
-			}
-		}
-
-		var converterPair core.ConverterPair
-		firstCurrency, err := u.GetCurrency(ctx, *firstId)
-		if err != nil {
-			logrus.WithFields(logrus.Fields{
-				"error": err,
-				"id":    firstId,
-			}).Error("error get first currency")
-			return nil, err
-		}
-		converterPair.Currencies = append(converterPair.Currencies, *firstCurrency)
-
-		secondCurrency, err := u.GetCurrency(ctx, *secondId)
-		if err != nil {
-			logrus.WithFields(logrus.Fields{
-				"error": err,
-				"id":    secondId,
-			}).Error("error get second currency")
-			return nil, err
-		}
-		converterPair.Currencies = append(converterPair.Currencies, *secondCurrency)
-
-		if *level == 3 {
-			thirdCurrency, err := u.GetCurrency(ctx, *thirdId)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{
-					"error": err,
-					"id":    thirdId,
-				}).Error("error get third currency")
-				return nil, err
-			}
-			converterPair.Currencies = append(converterPair.Currencies, *thirdCurrency)
-		}
-
-		converterPairs = append(converterPairs, converterPair)
+	converterPairIds, err := scanConverterPairIds(rows)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"query": query,
+		}).Error("error scan row")
+		return nil, err
 	}
 
-	return converterPairs, nil
+	return u.getConverterPairsByIds(ctx, converterPairIds)
 }
 
 func (u *UserDb) AddConverterPairIfHasNot(ctx context.Context,
@@ -230,7 +195,9 @@ func (u *UserDb) AddConverterPairIfHasNot(ctx context.Context,
 				return 0, err
 			}
 			break
-		case core.ErrorCurrencyNotFound:
+		// CheckConverterPair reports unknown currencies of the pair as invalid pair, they are
+		// added with the pair
+		case core.ErrorConverterInvalidConverterPair:
 			id, err = u.AddConverterPair(ctx, converterPair)
 			if err != nil {
 				return 0, err
@@ -261,11 +228,16 @@ func (u *UserDb) SetUserConverterPair(ctx context.Context, userId int,
 		db = tx
 	}
 
+	// nothing is inserted when the pair is disabled
 	query := `	INSERT INTO 
 	    			user_converter_pairs
 					(user_id, converter_pair_id)
-        		VALUES
-        		    ($1, $2)
+				SELECT
+					$1::int, id
+				FROM
+					converter_pairs
+				WHERE
+					id = $2 AND` + availableConverterPairCondition + `
 				RETURNING 
 					id`
 
@@ -273,6 +245,9 @@ func (u *UserDb) SetUserConverterPair(ctx context.Context, userId int,
 
 	var userConverterPairId int
 	if err := row.Scan(&userConverterPairId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, core.ErrorConverterConverterPairDisabled
+		}
 		if pgErr, ok := err.(*pgconn.PgError); ok {
 			logrus.WithFields(logrus.Fields{
 				"query":           query,
@@ -283,6 +258,8 @@ func (u *UserDb) SetUserConverterPair(ctx context.Context, userId int,
 			switch pgErr.Code {
 			case "23505":
 				return 0, core.ErrorConverterConverterPairAlreadyExists
+			case "23503":
+				return 0, core.ErrorAuthServiceUserNotFound
 			default:
 				return 0, err
 			}
@@ -309,7 +286,7 @@ func (u *UserDb) GetUserConverterPairs(ctx context.Context, userId int) ([]core.
 	}
 
 	query := `	SELECT
-	    			level, first_currency_id, second_currency_id, third_currency_id
+	    			id
 				FROM
 				    converter_pairs
                 WHERE
@@ -319,7 +296,9 @@ func (u *UserDb) GetUserConverterPairs(ctx context.Context, userId int) ([]core.
                 		 FROM
                 		     user_converter_pairs
                 		 WHERE 
-                		     user_id = $1)`
+                		     user_id = $1) AND` + availableConverterPairCondition + `
+				ORDER BY
+					id`
 
 	rows, err := db.Query(ctx, query, userId)
 	if err != nil {
@@ -343,78 +322,391 @@ func (u *UserDb) GetUserConverterPairs(ctx context.Context, userId int) ([]core.
 		}
 	}
 
-	var converterPairs []core.ConverterPair
+	converterPairIds, err := scanConverterPairIds(rows)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  query,
+			"userId": userId,
+			"error":  err,
+		}).Error("error scan row when get user converter pair")
+		return nil, err
+	}
 
+	return u.getConverterPairsByIds(ctx, converterPairIds)
+}
+
+func (u *UserDb) SetThresholdConvertPair(ctx context.Context, userId int,
+	threshold core.ThresholdConvertPair) error {
+	userConverterPairId, err := u.getUserConverterPairId(ctx, userId, threshold.ConverterPair)
+	if err != nil {
+		return err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	INSERT INTO
+					user_converter_pair_thresholds
+					(user_id, user_converter_pair_id, threshold)
+				VALUES
+					($1, $2, $3)
+				ON CONFLICT
+					(user_id, user_converter_pair_id, threshold)
+				DO NOTHING`
+
+	_, err = db.Exec(ctx, query, userId, userConverterPairId, formatExchange(threshold.Exchange))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":               logQuery(query),
+			"userId":              userId,
+			"userConverterPairId": userConverterPairId,
+			"threshold":           threshold.Exchange,
+			"error":               err,
+		}).Error("error set threshold of converter pair")
+		return err
+	}
+
+	return nil
+}
+
+func (u *UserDb) DeleteThresholdConvertPair(ctx context.Context, userId int,
+	converterPair core.ConverterPair) error {
+	userConverterPairId, err := u.getUserConverterPairId(ctx, userId, converterPair)
+	if err != nil {
+		return err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	DELETE FROM
+					user_converter_pair_thresholds
+				WHERE
+					user_id = $1 AND
+					user_converter_pair_id = $2`
+
+	_, err = db.Exec(ctx, query, userId, userConverterPairId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":               logQuery(query),
+			"userId":              userId,
+			"userConverterPairId": userConverterPairId,
+			"error":               err,
+		}).Error("error delete thresholds of converter pair")
+		return err
+	}
+
+	return nil
+}
+
+func (u *UserDb) GetThresholdConvertPair(ctx context.Context,
+	userId int) ([]core.ThresholdConvertPair, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					ucp.converter_pair_id, t.threshold
+				FROM
+					user_converter_pair_thresholds t
+					JOIN user_converter_pairs ucp ON ucp.id = t.user_converter_pair_id
+				WHERE
+					t.user_id = $1
+				ORDER BY
+					ucp.converter_pair_id, t.threshold`
+
+	rows, err := db.Query(ctx, query, userId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error run query when get thresholds of converter pairs")
+		return nil, err
+	}
+
+	type thresholdRow struct {
+		converterPairId int
+		threshold       core.Exchange
+	}
+
+	var thresholdRows []thresholdRow
 	for rows.Next() {
-		var level, firstId, secondId, thirdId *int
-		if err := rows.Scan(&level, &firstId, &secondId, &thirdId); err != nil {
-			if pgErr, ok := err.(*pgconn.PgError); ok {
-				logrus.WithFields(logrus.Fields{
-					"query":  query,
-					"userId": userId,
-					"pgErr":  pgErr,
-				}).Error("error scan row when get user converter pair")
-				switch pgErr.Code {
-				default:
-					return nil, err
-				}
-			} else {
-				logrus.WithFields(logrus.Fields{
-					"query":  query,
-					"userId": userId,
-					"error":  err,
-				}).Error("error scan row when get user converter pair")
-				return nil, err
-			}
-		}
-		var converterPair core.ConverterPair
-		firstCurrency, err := u.GetCurrency(ctx, *firstId)
-		if err != nil {
+		var row thresholdRow
+		if err := rows.Scan(&row.converterPairId, &row.threshold); err != nil {
+			rows.Close()
 			logrus.WithFields(logrus.Fields{
-				"userId":  userId,
-				"error":   err,
-				"firstId": firstId,
-			}).Error("error get first currency when get user converter pair")
+				"query":  logQuery(query),
+				"userId": userId,
+				"error":  err,
+			}).Error("error scan row when get thresholds of converter pairs")
 			return nil, err
 		}
-		converterPair.Currencies = append(converterPair.Currencies, *firstCurrency)
+		thresholdRows = append(thresholdRows, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-		secondCurrency, err := u.GetCurrency(ctx, *secondId)
+	var thresholds []core.ThresholdConvertPair
+	for _, row := range thresholdRows {
+		converterPair, err := u.getConverterPairById(ctx, row.converterPairId)
 		if err != nil {
 			logrus.WithFields(logrus.Fields{
-				"userId":   userId,
-				"error":    err,
-				"secondId": secondId,
-			}).Error("error get second currency when get user converter pair")
+				"userId": userId,
+				"error":  err,
+			}).Error("error get converter pair when get thresholds of converter pairs")
 			return nil, err
 		}
-		converterPair.Currencies = append(converterPair.Currencies, *secondCurrency)
+		thresholds = append(thresholds, core.ThresholdConvertPair{
+			ConverterPair: converterPair,
+			Exchange:      row.threshold,
+		})
+	}
 
-		if *level == 3 {
-			thirdCurrency, err := u.GetCurrency(ctx, *thirdId)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{
-					"userId":  userId,
-					"error":   err,
-					"thirdId": thirdId,
-				}).Error("error get third currency when get user converter pair")
-				return nil, err
-			}
-			converterPair.Currencies = append(converterPair.Currencies, *thirdCurrency)
+	return thresholds, nil
+}
+
+func (u *UserDb) getUserConverterPairId(ctx context.Context, userId int,
+	converterPair core.ConverterPair) (int, error) {
+	converterPairId, err := u.CheckConverterPair(ctx, converterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err.Error(),
+		}).Error("error check converter pair")
+		return 0, err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					id
+				FROM
+					user_converter_pairs
+				WHERE
+					user_id = $1 AND
+					converter_pair_id = $2`
+
+	var userConverterPairId int
+	err = db.QueryRow(ctx, query, userId, converterPairId).Scan(&userConverterPairId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, core.ErrorConverterConverterPairNotSubscribed
+		}
+		logrus.WithFields(logrus.Fields{
+			"query":           logQuery(query),
+			"userId":          userId,
+			"converterPairId": converterPairId,
+			"error":           err,
+		}).Error("error get user converter pair")
+		return 0, err
+	}
+
+	return userConverterPairId, nil
+}
+
+func (u *UserDb) getConverterPairById(ctx context.Context,
+	converterPairId int) (core.ConverterPair, error) {
+	query := `	SELECT
+					currency_id
+				FROM
+					converter_pair_steps
+				WHERE
+					converter_pair_id = $1
+				ORDER BY
+					position`
+
+	return u.getConverterPairBySteps(ctx, query, converterPairId)
+}
+
+// getConverterPairBySteps resolves currencies of steps selected by query of currency ids ordered by
+// position of steps of the owner
+func (u *UserDb) getConverterPairBySteps(ctx context.Context, query string,
+	ownerId interface{}) (core.ConverterPair, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	rows, err := db.Query(ctx, query, ownerId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":   logQuery(query),
+			"ownerId": ownerId,
+			"error":   err,
+		}).Error("error run query when get steps")
+		return core.ConverterPair{}, err
+	}
+
+	var currencyIds []int
+	for rows.Next() {
+		var currencyId int
+		if err := rows.Scan(&currencyId); err != nil {
+			rows.Close()
+			logrus.WithFields(logrus.Fields{
+				"query":   logQuery(query),
+				"ownerId": ownerId,
+				"error":   err,
+			}).Error("error scan row when get steps")
+			return core.ConverterPair{}, err
+		}
+		currencyIds = append(currencyIds, currencyId)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return core.ConverterPair{}, err
+	}
+
+	if len(currencyIds) < 2 {
+		return core.ConverterPair{}, core.ErrorConverterInvalidConverterPair
+	}
+
+	var converterPair core.ConverterPair
+	for _, id := range currencyIds {
+		currency, err := u.GetCurrency(ctx, id)
+		if err != nil {
+			return core.ConverterPair{}, err
+		}
+		converterPair.Currencies = append(converterPair.Currencies, *currency)
+	}
+	return converterPair, nil
+}
+
+func (u *UserDb) getConverterPairsByIds(ctx context.Context,
+	converterPairIds []int) ([]core.ConverterPair, error) {
+	var converterPairs []core.ConverterPair
+	for _, converterPairId := range converterPairIds {
+		converterPair, err := u.getConverterPairById(ctx, converterPairId)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error":           err,
+				"converterPairId": converterPairId,
+			}).Error("error get converter pair")
+			return nil, err
 		}
 		converterPairs = append(converterPairs, converterPair)
 	}
 	return converterPairs, nil
 }
 
-func (u *UserDb) SetThresholdConvertPair(ctx context.Context, userId int,
-	threshold core.ThresholdConvertPair) error {
-	//TODO implement me
-	panic("implement me")
+// scanConverterPairIds reads ids of converter pairs and closes rows, so converter pairs may be
+// resolved on the same connection afterwards
+func scanConverterPairIds(rows pgx.Rows) ([]int, error) {
+	defer rows.Close()
+
+	var converterPairIds []int
+	for rows.Next() {
+		var converterPairId int
+		if err := rows.Scan(&converterPairId); err != nil {
+			return nil, err
+		}
+		converterPairIds = append(converterPairIds, converterPairId)
+	}
+	return converterPairIds, rows.Err()
 }
 
-func (u *UserDb) GetThresholdConvertPair(ctx context.Context,
-	userId int) ([]core.ThresholdConvertPair, error) {
-	//TODO implement me
-	panic("implement me")
+// converterPairRoute returns '/' separated currency ids of steps of converter pair, route is
+// unique for converter pair
+func converterPairRoute(currencyIds []int) string {
+	route := make([]string, 0, len(currencyIds))
+	for _, currencyId := range currencyIds {
+		route = append(route, strconv.Itoa(currencyId))
+	}
+	return strings.Join(route, "/")
+}
+
+func formatExchange(exchange core.Exchange) string {
+	return exchange.String()
+}
+
+func (u *UserDb) GetAllThresholdConvertPairs(ctx context.Context) ([]core.UserThresholdConvertPair,
+	error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					u.id, u.chat_id, ucp.converter_pair_id, t.threshold
+				FROM
+					user_converter_pair_thresholds t
+					JOIN users u ON u.id = t.user_id
+					JOIN user_converter_pairs ucp ON ucp.id = t.user_converter_pair_id
+				WHERE
+					u.chat_id IS NOT NULL
+				ORDER BY
+					ucp.converter_pair_id, u.id, t.threshold`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error run query when get all thresholds of converter pairs")
+		return nil, err
+	}
+
+	type thresholdRow struct {
+		userId          int
+		chatId          int64
+		converterPairId int
+		threshold       core.Exchange
+	}
+
+	var thresholdRows []thresholdRow
+	for rows.Next() {
+		var row thresholdRow
+		if err := rows.Scan(&row.userId, &row.chatId, &row.converterPairId,
+			&row.threshold); err != nil {
+			rows.Close()
+			logrus.WithFields(logrus.Fields{
+				"query": logQuery(query),
+				"error": err,
+			}).Error("error scan row when get all thresholds of converter pairs")
+			return nil, err
+		}
+		thresholdRows = append(thresholdRows, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var thresholds []core.UserThresholdConvertPair
+	for _, row := range thresholdRows {
+		converterPair, err := u.getConverterPairById(ctx, row.converterPairId)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"userId": row.userId,
+				"error":  err,
+			}).Error("error get converter pair when get all thresholds of converter pairs")
+			return nil, err
+		}
+		thresholds = append(thresholds, core.UserThresholdConvertPair{
+			UserId: row.userId,
+			ChatId: row.chatId,
+			Threshold: core.ThresholdConvertPair{
+				ConverterPair: converterPair,
+				Exchange:      row.threshold,
+			},
+		})
+	}
+
+	return thresholds, nil
 }
@@ -1,8 +1,11 @@
 package userDbPostgres
 
 import (
+	"errors"
 	"github.com/binance-converter/backend/core"
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
 
@@ -96,6 +99,9 @@ func (u *UserDb) CheckCurrency(ctx context.Context,
 
 	var rows int
 	if err := row.Scan(&rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, core.ErrorCurrencyNotFound
+		}
 		if pgErr, ok := err.(*pgconn.PgError); ok {
 			switch pgErr.Code {
 			case "no rows in result set":
@@ -150,6 +156,9 @@ func (u *UserDb) GetCurrency(ctx context.Context, currencyId int) (*core.FullCur
 	var currencyType string
 
 	if err := row.Scan(&currencyType, &currency.CurrencyCode, &currency.BankCode); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, core.ErrorCurrencyNotFound
+		}
 		if pgErr, ok := err.(*pgconn.PgError); ok {
 			switch pgErr.Code {
 			case "no rows in result set":
@@ -199,7 +208,8 @@ func (u *UserDb) GetAvailableClassicCurrencies(ctx context.Context) ([]core.Curr
                 FROM
                     currencies
                 WHERE
-                    type = 'classic'`
+                    type = 'classic' AND
+                    NOT disabled`
 
 	rows, err := db.Query(ctx, query)
 	if err != nil {
@@ -233,7 +243,8 @@ func (u *UserDb) GetAvailableBanks(ctx context.Context, currency core.CurrencyCo
                     currencies
                 WHERE
                     type = 'classic' AND
-                    code = $1`
+                    code = $1 AND
+                    NOT disabled`
 
 	rows, err := db.Query(ctx, query, currency)
 	if err != nil {
@@ -266,7 +277,8 @@ func (u *UserDb) GetAvailableCryptoCurrencies(ctx context.Context) ([]core.Curre
                 FROM
                     currencies
                 WHERE
-                    type = 'crypto'`
+                    type = 'crypto' AND
+                    NOT disabled`
 
 	rows, err := db.Query(ctx, query)
 	if err != nil {
@@ -352,6 +364,8 @@ func (u *UserDb) AddUserCurrency(ctx context.Context, userId int,
 	return userCurrencyId, nil
 }
 
+// GetUserCurrencies returns currencies of the user in order they were added, all types are
+// returned when currencyType is nil
 func (u *UserDb) GetUserCurrencies(ctx context.Context, userId int,
 	currencyType *core.CurrencyType) ([]core.FullCurrency, error) {
 
@@ -362,71 +376,93 @@ func (u *UserDb) GetUserCurrencies(ctx context.Context, userId int,
 	}
 
 	query := `	SELECT
-   					(type, code, bank_code)
+   					c.type, c.code, c.bank_code
 				FROM
-    				currencies
+    				user_currencies uc
+    				JOIN currencies c ON c.id = uc.currency_id
 				WHERE
-    				id IN (
-    				SELECT
-      		   			id
-    			 	FROM
-         				user_currencies
-     				WHERE
-         				user_id = $1)`
+    				uc.user_id = $1`
 
-	var additionalArgs []interface{}
+	args := []interface{}{userId}
 
 	if currencyType != nil {
-		query += " AND type = $2"
-		additionalArgs = append(additionalArgs, *currencyType)
+		postgresCurrencyType, err := u.convertCoreCurrencyTypeToPostgres(*currencyType)
+		if err != nil {
+			return nil, err
+		}
+		query += " AND c.type = $2"
+		args = append(args, postgresCurrencyType)
 	}
+	query += " ORDER BY uc.id"
 
-	rows, err := db.Query(ctx, query, userId, additionalArgs)
+	rows, err := db.Query(ctx, query, args...)
 	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error run query when get user currencies")
 		return nil, err
 	}
+	defer rows.Close()
 
 	var currencies []core.FullCurrency
 
 	for rows.Next() {
 		var currency core.FullCurrency
-		var currencyCode string
-		err = rows.Scan(&currencyCode, &currency.CurrencyCode, &currency.BankCode)
-		if err != nil {
-			if pgErr, ok := err.(*pgconn.PgError); ok {
-				switch pgErr.Code {
-				default:
-					//logrus.WithFields(logrus.Fields{
-					//	"base":    logBase,
-					//	"massage": pgErr.Message,
-					//	"where":   pgErr.Where,
-					//	"detail":  pgErr.Detail,
-					//	"code":    pgErr.Code,
-					//	"query":   logQuery(query),
-					//}).Error("error scan rows")
-					return nil, err
-				}
-			} else {
-				//logrus.WithFields(logrus.Fields{
-				//	"base":  logBase,
-				//	"query": logQuery(query),
-				//	"error": err,
-				//}).Error("error scan rows")
-				return nil, err
-			}
+		var currencyType string
+		if err := rows.Scan(&currencyType, &currency.CurrencyCode, &currency.BankCode); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query":  logQuery(query),
+				"userId": userId,
+				"error":  err,
+			}).Error("error scan row when get user currencies")
+			return nil, err
 		}
-		currency.CurrencyType, err = u.convertPostgresCurrencyTypeToCore(currencyCode)
-		if err == nil {
-			currencies = append(currencies, currency)
+		currency.CurrencyType, err = u.convertPostgresCurrencyTypeToCore(currencyType)
+		if err != nil {
+			return nil, err
 		}
+		currencies = append(currencies, currency)
 	}
-	return currencies, nil
+	return currencies, rows.Err()
 }
 
+// DeleteUserCurrency deletes currencies of the user with the code of all banks
 func (u *UserDb) DeleteUserCurrency(ctx context.Context, userId int,
 	currency core.CurrencyCode) error {
-	//TODO implement me
-	panic("implement me")
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	DELETE FROM
+					user_currencies
+				WHERE
+					user_id = $1 AND
+					currency_id IN (
+						SELECT
+							id
+						FROM
+							currencies
+						WHERE
+							code = $2)`
+
+	result, err := db.Exec(ctx, query, userId, currency)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":    logQuery(query),
+			"userId":   userId,
+			"currency": currency,
+			"error":    err,
+		}).Error("error delete user currency")
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return core.ErrorCurrencyNotFound
+	}
+	return nil
 }
 
 func (u *UserDb) convertCoreCurrencyTypeToPostgres(currencyType core.CurrencyType) (string, error) {
@@ -0,0 +1,171 @@
+package userDbPostgres
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (u *UserDb) AddExchangeRate(ctx context.Context, rate core.ExchangeRate) (int64, error) {
+	converterPairId, err := u.CheckConverterPair(ctx, rate.ConverterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": rate.ConverterPair,
+			"error":         err.Error(),
+		}).Error("error check converter pair")
+		return 0, err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	INSERT INTO
+					exchange_rates
+					(converter_pair_id, rate, sampled_at, source)
+				VALUES
+					($1, $2, $3, $4)
+				RETURNING
+					id`
+
+	var exchangeRateId int64
+	err = db.QueryRow(ctx, query, converterPairId, formatExchange(rate.Exchange), rate.SampledAt,
+		rate.Source).Scan(&exchangeRateId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"rate":  rate,
+			"error": err,
+		}).Error("error add exchange rate")
+		return 0, err
+	}
+
+	return exchangeRateId, nil
+}
+
+func (u *UserDb) GetExchangeRates(ctx context.Context, converterPair core.ConverterPair,
+	interval core.TimeInterval) ([]core.ExchangeRate, error) {
+	converterPairId, err := u.CheckConverterPair(ctx, converterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err.Error(),
+		}).Error("error check converter pair")
+		return nil, err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					rate, sampled_at, source
+				FROM
+					exchange_rates
+				WHERE
+					converter_pair_id = $1 AND
+					sampled_at >= $2 AND
+					sampled_at <= $3
+				ORDER BY
+					sampled_at`
+
+	rows, err := db.Query(ctx, query, converterPairId, interval.Start(), interval.End())
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":           logQuery(query),
+			"converterPairId": converterPairId,
+			"error":           err,
+		}).Error("error run query when get exchange rates")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []core.ExchangeRate
+	for rows.Next() {
+		rate := core.ExchangeRate{
+			ConverterPair: converterPair,
+		}
+		if err := rows.Scan(&rate.Exchange, &rate.SampledAt, &rate.Source); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query":           logQuery(query),
+				"converterPairId": converterPairId,
+				"error":           err,
+			}).Error("error scan row when get exchange rates")
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, rows.Err()
+}
+
+func (u *UserDb) GetExchangeRateCandles(ctx context.Context, converterPair core.ConverterPair,
+	timeframe time.Duration, interval core.TimeInterval) ([]core.Candle, error) {
+	converterPairId, err := u.CheckConverterPair(ctx, converterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err.Error(),
+		}).Error("error check converter pair")
+		return nil, err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					to_timestamp(floor(extract(epoch FROM sampled_at) / $4) * $4) AS open_time,
+					(array_agg(rate ORDER BY sampled_at))[1],
+					max(rate),
+					min(rate),
+					(array_agg(rate ORDER BY sampled_at DESC))[1],
+					count(*)
+				FROM
+					exchange_rates
+				WHERE
+					converter_pair_id = $1 AND
+					sampled_at >= $2 AND
+					sampled_at <= $3
+				GROUP BY
+					open_time
+				ORDER BY
+					open_time`
+
+	rows, err := db.Query(ctx, query, converterPairId, interval.Start(), interval.End(),
+		int64(timeframe/time.Second))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":           logQuery(query),
+			"converterPairId": converterPairId,
+			"timeframe":       timeframe,
+			"error":           err,
+		}).Error("error run query when get exchange rate candles")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candles []core.Candle
+	for rows.Next() {
+		var candle core.Candle
+		if err := rows.Scan(&candle.OpenTime, &candle.Open, &candle.High, &candle.Low,
+			&candle.Close, &candle.SampleCount); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query":           logQuery(query),
+				"converterPairId": converterPairId,
+				"error":           err,
+			}).Error("error scan row when get exchange rate candles")
+			return nil, err
+		}
+		candles = append(candles, candle)
+	}
+
+	return candles, rows.Err()
+}
@@ -0,0 +1,150 @@
+package userDbPostgres
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) SetFeeSchedule(ctx context.Context, schedule core.FeeSchedule) error {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	scope, err := u.convertCoreFeeScopeToPostgres(schedule.Scope)
+	if err != nil {
+		return err
+	}
+
+	query := `	INSERT INTO
+					fee_schedules
+					(scope, key, fixed, percent, spread)
+				VALUES
+					($1, $2, $3, $4, $5)
+				ON CONFLICT
+					(scope, key)
+				DO UPDATE SET
+					fixed = excluded.fixed,
+					percent = excluded.percent,
+					spread = excluded.spread,
+					updated_at = now()`
+
+	if _, err := db.Exec(ctx, query, scope, schedule.Key, formatFee(schedule.Fixed),
+		formatFee(schedule.Percent), formatFee(schedule.Spread)); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":    logQuery(query),
+			"schedule": schedule,
+			"error":    err,
+		}).Error("error set fee schedule")
+		return err
+	}
+	return nil
+}
+
+func (u *UserDb) DeleteFeeSchedule(ctx context.Context, scope core.FeeScope, key string) error {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	postgresScope, err := u.convertCoreFeeScopeToPostgres(scope)
+	if err != nil {
+		return err
+	}
+
+	query := `	DELETE FROM
+					fee_schedules
+				WHERE
+					scope = $1 AND key = $2`
+
+	tag, err := db.Exec(ctx, query, postgresScope, key)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"scope": scope,
+			"key":   key,
+			"error": err,
+		}).Error("error delete fee schedule")
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return core.ErrorFeeNotFound
+	}
+	return nil
+}
+
+func (u *UserDb) GetFeeSchedules(ctx context.Context) ([]core.FeeSchedule, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					scope, key, fixed, percent, spread
+				FROM
+					fee_schedules
+				ORDER BY
+					scope, key`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error run query when get fee schedules")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schedules []core.FeeSchedule
+	for rows.Next() {
+		var scope string
+		var schedule core.FeeSchedule
+		if err := rows.Scan(&scope, &schedule.Key, &schedule.Fixed, &schedule.Percent,
+			&schedule.Spread); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query": logQuery(query),
+				"error": err,
+			}).Error("error scan row when get fee schedules")
+			return nil, err
+		}
+		schedule.Scope, err = u.convertPostgresFeeScopeToCore(scope)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, rows.Err()
+}
+
+func (u *UserDb) convertCoreFeeScopeToPostgres(scope core.FeeScope) (string, error) {
+	switch scope {
+	case core.FeeScopeBank:
+		return "bank", nil
+	case core.FeeScopeProvider:
+		return "provider", nil
+	default:
+		return "", core.ErrorFeeInvalidScope
+	}
+}
+
+func (u *UserDb) convertPostgresFeeScopeToCore(scope string) (core.FeeScope, error) {
+	switch scope {
+	case "bank":
+		return core.FeeScopeBank, nil
+	case "provider":
+		return core.FeeScopeProvider, nil
+	default:
+		return 0, core.ErrorFeeInvalidScope
+	}
+}
+
+func formatFee(fee decimal.Decimal) string {
+	return fee.String()
+}
@@ -0,0 +1,327 @@
+package userDbPostgres
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/binance-converter/backend/internal/storage/migration"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"io/fs"
+	"sort"
+)
+
+// migrationsLockKey is a key of the advisory lock held while migrations are applied, so replicas
+// started together do not migrate concurrently
+const migrationsLockKey = 4120753312
+
+// Migrator applies versioned migrations and records applied versions in schema_migrations table
+type Migrator struct {
+	pool       *pgxpool.Pool
+	migrations []core.Migration
+}
+
+func NewMigrator(pool *pgxpool.Pool, migrations fs.FS) (*Migrator, error) {
+	loaded, err := migration.Load(migrations)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+		}).Error("error load migrations")
+		return nil, err
+	}
+	return &Migrator{pool: pool, migrations: loaded}, nil
+}
+
+// Baseline records migrations up to version as applied without running them, it is used once for
+// databases migrated by hand and does nothing when any version is already recorded
+func (m *Migrator) Baseline(ctx context.Context, version int) error {
+	if version < 0 {
+		return core.ErrorMigrationInvalidBaseline
+	}
+	if version == 0 {
+		return nil
+	}
+
+	return m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(applied) > 0 {
+			return nil
+		}
+
+		query := `	INSERT INTO
+						schema_migrations
+						(version, name)
+					VALUES
+						($1, $2)`
+
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if _, err := conn.Exec(ctx, query, migration.Version, migration.Name); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"query":   logQuery(query),
+					"version": migration.Version,
+					"error":   err,
+				}).Error("error record baseline migration")
+				return err
+			}
+		}
+		logrus.WithFields(logrus.Fields{
+			"version": version,
+		}).Info("migrations baseline recorded")
+		return nil
+	})
+}
+
+// Up applies pending migrations in order of versions, each migration is applied in its own
+// transaction, versions of applied migrations are returned
+func (m *Migrator) Up(ctx context.Context) ([]int, error) {
+	var versions []int
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		query := `	INSERT INTO
+						schema_migrations
+						(version, name)
+					VALUES
+						($1, $2)`
+
+		if len(applied) == 0 {
+			exists, err := m.schemaExists(ctx, conn)
+			if err != nil {
+				return err
+			}
+			if exists {
+				logrus.Error("schema exists without recorded migrations, " +
+					"baseline version is required")
+				return core.ErrorMigrationUnversionedSchema
+			}
+		}
+
+		for _, migration := range m.migrations {
+			if applied[migration.Version] {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration, migration.Up, query, migration.Version,
+				migration.Name); err != nil {
+				return err
+			}
+			versions = append(versions, migration.Version)
+			logrus.WithFields(logrus.Fields{
+				"version": migration.Version,
+				"name":    migration.Name,
+			}).Info("migration applied")
+		}
+		return nil
+	})
+	return versions, err
+}
+
+// Down rolls back steps latest applied migrations, versions of rolled back migrations are returned
+func (m *Migrator) Down(ctx context.Context, steps int) ([]int, error) {
+	if steps <= 0 {
+		return nil, core.ErrorMigrationInvalidSteps
+	}
+
+	var versions []int
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		latest := make([]int, 0, len(applied))
+		for version := range applied {
+			latest = append(latest, version)
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(latest)))
+		if len(latest) > steps {
+			latest = latest[:steps]
+		}
+
+		query := `	DELETE FROM
+						schema_migrations
+					WHERE
+						version = $1`
+
+		for _, version := range latest {
+			migration, ok := m.find(version)
+			if !ok {
+				logrus.WithFields(logrus.Fields{
+					"version": version,
+				}).Error("applied migration is not known")
+				return core.ErrorMigrationNotFound
+			}
+			if migration.Down == "" {
+				logrus.WithFields(logrus.Fields{
+					"version": version,
+					"name":    migration.Name,
+				}).Error("migration can not be rolled back")
+				return core.ErrorMigrationIrreversible
+			}
+			if err := m.apply(ctx, conn, migration, migration.Down, query,
+				migration.Version); err != nil {
+				return err
+			}
+			versions = append(versions, migration.Version)
+			logrus.WithFields(logrus.Fields{
+				"version": migration.Version,
+				"name":    migration.Name,
+			}).Info("migration rolled back")
+		}
+		return nil
+	})
+	return versions, err
+}
+
+// Version returns the latest applied version, zero is returned for an empty database
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	var latest int
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for version := range applied {
+			if version > latest {
+				latest = version
+			}
+		}
+		return nil
+	})
+	return latest, err
+}
+
+// withLock runs fn on a single connection holding the advisory lock, the advisory lock belongs to
+// the session, so it is taken and released on the same connection
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+		}).Error("error acquire connection for migrations")
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, migrationsLockKey); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+		}).Error("error take migrations lock")
+		return err
+	}
+	defer func() {
+		// lock is released with the session if the connection is broken
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`,
+			migrationsLockKey); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err,
+			}).Error("error release migrations lock")
+		}
+	}()
+
+	query := `	CREATE TABLE IF NOT EXISTS schema_migrations
+				(
+					version    bigint primary key,
+					name       varchar(255) not null,
+					applied_at timestamptz  not null default now()
+				)`
+
+	if _, err := conn.Exec(ctx, query); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error create migrations table")
+		return err
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context, conn *pgxpool.Conn) (map[int]bool,
+	error) {
+	query := `	SELECT
+					version
+				FROM
+					schema_migrations`
+
+	rows, err := conn.Query(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error get applied migrations")
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+	return applied, rows.Err()
+}
+
+// schemaExists reports whether tables of the first migration exist, so a database migrated by hand
+// is not migrated again from the first version
+func (m *Migrator) schemaExists(ctx context.Context, conn *pgxpool.Conn) (bool, error) {
+	query := `	SELECT
+					to_regclass('users') IS NOT NULL`
+
+	var exists bool
+	if err := conn.QueryRow(ctx, query).Scan(&exists); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error check existing schema")
+		return false, err
+	}
+	return exists, nil
+}
+
+// apply runs sql of the migration and the query recording it in one transaction
+func (m *Migrator) apply(ctx context.Context, conn *pgxpool.Conn, migration core.Migration,
+	sql string, query string, args ...interface{}) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, sql); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"version": migration.Version,
+			"name":    migration.Name,
+			"error":   err,
+		}).Error("error run migration")
+		return err
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":   logQuery(query),
+			"version": migration.Version,
+			"error":   err,
+		}).Error("error record migration")
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (m *Migrator) find(version int) (core.Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return core.Migration{}, false
+}
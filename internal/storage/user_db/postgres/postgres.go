@@ -12,7 +12,8 @@ import (
 
 const (
 	maxAttemptsForConnect = 5
-	attemptsInterval      = 5 * time.Second
+	connectBaseDelay      = time.Second
+	connectMaxDelay       = 5 * time.Second
 	connectionTimeout     = 5 * time.Second
 )
 
@@ -36,13 +37,20 @@ func NewPostgresDB(ctx context.Context, cfg Config) (*pgxpool.Pool, error) {
 
 	var pool *pgxpool.Pool
 
-	err := utils.DoWithTries(func() error {
+	err := utils.DoWithBackoff(ctx, func(ctx context.Context) error {
 		ctx, cancel := context.WithTimeout(ctx, connectionTimeout)
 		defer cancel()
 		var err error
 		pool, err = pgxpool.Connect(ctx, dns)
 		return err
-	}, maxAttemptsForConnect, attemptsInterval)
+	}, utils.Backoff{
+		Attempts:  maxAttemptsForConnect,
+		BaseDelay: connectBaseDelay,
+		MaxDelay:  connectMaxDelay,
+	}, func(err error) bool {
+		// database may be not started yet, so every error is retried
+		return true
+	})
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -0,0 +1,148 @@
+package userDbPostgres
+
+import (
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/binance-converter/backend/internal/storage/storagetest"
+	"github.com/binance-converter/backend/schema"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"golang.org/x/net/context"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// newTestPool connects to a disposable database of POSTGRES_TEST_URL and recreates its public
+// schema, the test is skipped when the url is not set
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	url := os.Getenv("POSTGRES_TEST_URL")
+	if url == "" {
+		t.Skip("POSTGRES_TEST_URL is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.Connect(ctx, url)
+	if err != nil {
+		t.Fatalf("connect to postgres: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	if _, err := pool.Exec(ctx, `DROP SCHEMA public CASCADE; CREATE SCHEMA public`); err != nil {
+		t.Fatalf("recreate schema: %v", err)
+	}
+	return pool
+}
+
+// TestUserDbConformance runs the suite against a disposable database of POSTGRES_TEST_URL, public
+// schema of the database is recreated for every test
+func TestUserDbConformance(t *testing.T) {
+	if os.Getenv("POSTGRES_TEST_URL") == "" {
+		t.Skip("POSTGRES_TEST_URL is not set")
+	}
+
+	storagetest.Run(t, func(t *testing.T) storagetest.UserDb {
+		pool := newTestPool(t)
+
+		migrator, err := NewMigrator(pool, schema.Migrations)
+		if err != nil {
+			t.Fatalf("load migrations: %v", err)
+		}
+		if _, err := migrator.Up(context.Background()); err != nil {
+			t.Fatalf("apply migrations: %v", err)
+		}
+
+		return NewUserDB(pool, NewTransaction(pool))
+	})
+}
+
+// TestMigratorUnversionedSchema checks that a database migrated by hand is not migrated again from
+// the first version until its version is recorded as the baseline
+func TestMigratorUnversionedSchema(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+
+	first, err := schema.Migrations.ReadFile("000001_init.up.sql")
+	if err != nil {
+		t.Fatalf("read first migration: %v", err)
+	}
+	if _, err := pool.Exec(ctx, string(first)); err != nil {
+		t.Fatalf("apply first migration by hand: %v", err)
+	}
+
+	migrator, err := NewMigrator(pool, schema.Migrations)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	if _, err := migrator.Up(ctx); !errors.Is(err, core.ErrorMigrationUnversionedSchema) {
+		t.Fatalf("up without baseline: got %v, want %v", err, core.ErrorMigrationUnversionedSchema)
+	}
+
+	if err := migrator.Baseline(ctx, 1); err != nil {
+		t.Fatalf("record baseline: %v", err)
+	}
+	versions, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("up after baseline: %v", err)
+	}
+	if len(versions) == 0 || versions[0] != 2 {
+		t.Fatalf("applied versions: got %v, want versions after the baseline", versions)
+	}
+}
+
+// TestMigratorConcurrentUp checks that migrators started together apply every migration once, the
+// advisory lock serializes them
+func TestMigratorConcurrentUp(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+
+	const replicas = 3
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		versions []int
+		errs     []error
+	)
+	for i := 0; i < replicas; i++ {
+		migrator, err := NewMigrator(pool, schema.Migrations)
+		if err != nil {
+			t.Fatalf("load migrations: %v", err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			applied, err := migrator.Up(ctx)
+			mu.Lock()
+			defer mu.Unlock()
+			versions = append(versions, applied...)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		t.Fatalf("concurrent up: %v", errs)
+	}
+	sort.Ints(versions)
+	for i := 1; i < len(versions); i++ {
+		if versions[i] == versions[i-1] {
+			t.Fatalf("version %d is applied more than once: %v", versions[i], versions)
+		}
+	}
+
+	migrator, err := NewMigrator(pool, schema.Migrations)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	latest, err := migrator.Version(ctx)
+	if err != nil {
+		t.Fatalf("get version: %v", err)
+	}
+	if len(versions) == 0 || latest != versions[len(versions)-1] {
+		t.Fatalf("latest version %d, applied versions %v", latest, versions)
+	}
+}
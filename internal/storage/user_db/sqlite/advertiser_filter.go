@@ -0,0 +1,104 @@
+package userDbSqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) SetUserAdvertiserFilter(ctx context.Context, userId int,
+	filter core.AdvertiserFilter) error {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	excluded := filter.ExcludedAdvertisers
+	if excluded == nil {
+		excluded = []string{}
+	}
+	// sqlite has no arrays, excluded advertisers are kept as json array
+	excludedJson, err := json.Marshal(excluded)
+	if err != nil {
+		return err
+	}
+
+	query := `	INSERT INTO
+					user_advertiser_filters
+					(user_id, merchant_only, min_completion_rate, min_order_count,
+					 excluded_advertisers)
+				VALUES
+					($1, $2, $3, $4, $5)
+				ON CONFLICT
+					(user_id)
+				DO UPDATE SET
+					merchant_only = excluded.merchant_only,
+					min_completion_rate = excluded.min_completion_rate,
+					min_order_count = excluded.min_order_count,
+					excluded_advertisers = excluded.excluded_advertisers,
+					updated_at = current_timestamp`
+
+	if _, err := db.ExecContext(ctx, query, userId, filter.MerchantOnly, filter.MinCompletionRate,
+		filter.MinOrderCount, string(excludedJson)); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"filter": filter,
+			"error":  err,
+		}).Error("error set advertiser filter of user")
+		return err
+	}
+	return nil
+}
+
+// GetUserAdvertiserFilter returns advertiser filter of the user, empty filter is returned when the
+// user has not set it
+func (u *UserDb) GetUserAdvertiserFilter(ctx context.Context, userId int) (core.AdvertiserFilter,
+	error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					merchant_only,
+					min_completion_rate,
+					min_order_count,
+					excluded_advertisers
+				FROM
+					user_advertiser_filters
+				WHERE
+					user_id = $1`
+
+	var filter core.AdvertiserFilter
+	var excludedJson string
+	if err := db.QueryRowContext(ctx, query, userId).Scan(&filter.MerchantOnly,
+		&filter.MinCompletionRate, &filter.MinOrderCount, &excludedJson); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return core.AdvertiserFilter{}, nil
+		}
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error get advertiser filter of user")
+		return core.AdvertiserFilter{}, err
+	}
+	if err := json.Unmarshal([]byte(excludedJson), &filter.ExcludedAdvertisers); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"userId":   userId,
+			"excluded": excludedJson,
+			"error":    err,
+		}).Error("error decode excluded advertisers of user")
+		return core.AdvertiserFilter{}, err
+	}
+	if len(filter.ExcludedAdvertisers) == 0 {
+		filter.ExcludedAdvertisers = nil
+	}
+	return filter, nil
+}
@@ -0,0 +1,119 @@
+package userDbSqlite
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) AddUser(ctx context.Context, user core.AddUser) (int, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	INSERT INTO users
+				    (chat_id, user_name, first_name, last_name, language_code) 
+				VALUES 
+				    ($1, $2, $3, $4, $5) 
+				RETURNING 
+					id`
+
+	row := db.QueryRowContext(ctx, query, user.ChatId, user.UserName, user.FirstName, user.LastName,
+		user.LanguageCode)
+
+	var userId int
+	if err := row.Scan(&userId); err != nil {
+		if isUniqueViolation(err) {
+			logrus.WithFields(logrus.Fields{
+				"query": logQuery(query),
+				"user":  user,
+				"error": err,
+			}).Error("user already has")
+			return 0, core.ErrorAuthServiceAuthUserAlreadyExists
+		}
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"user":  user,
+			"error": err,
+		}).Error("error add user to sqlite")
+		return 0, err
+	}
+
+	return userId, nil
+}
+
+func (u *UserDb) ValidateUser(ctx context.Context, chatId int) (int, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT 
+					id
+                FROM 
+                    users
+                WHERE 
+                    chat_id = $1`
+
+	row := db.QueryRowContext(ctx, query, chatId)
+
+	var userId int
+	if err := row.Scan(&userId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, core.ErrorAuthServiceUserNotFound
+		}
+		return 0, err
+	}
+	return userId, nil
+}
+
+func (u *UserDb) GetUsers(ctx context.Context) ([]core.User, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					id,
+					chat_id,
+					user_name,
+					first_name,
+					last_name,
+					language_code,
+					is_admin
+				FROM
+					users
+				ORDER BY
+					id`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error get users")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []core.User
+	for rows.Next() {
+		var user core.User
+		if err := rows.Scan(&user.Id, &user.ChatId, &user.UserName, &user.FirstName,
+			&user.LastName, &user.LanguageCode, &user.IsAdmin); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query": logQuery(query),
+				"error": err,
+			}).Error("error scan user")
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
@@ -0,0 +1,274 @@
+package userDbSqlite
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) SetCurrencyDisabled(ctx context.Context, currency core.FullCurrency,
+	disabled bool) error {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	currencyType, err := u.convertCoreCurrencyTypeToSqlite(currency.CurrencyType)
+	if err != nil {
+		return err
+	}
+
+	query := `	UPDATE
+					currencies
+				SET
+					disabled = $4
+				WHERE
+					type = $1 AND
+					code = $2 AND
+					bank_code = $3`
+
+	result, err := db.ExecContext(ctx, query, currencyType, currency.CurrencyCode,
+		currency.BankCode, disabled)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":    logQuery(query),
+			"currency": currency,
+			"disabled": disabled,
+			"error":    err,
+		}).Error("error set currency disabled")
+		return err
+	}
+	return requireAffected(result, core.ErrorCurrencyNotFound)
+}
+
+// DeleteCurrency deletes the currency with converter pairs, arbitrage opportunities and
+// subscriptions of users using it
+func (u *UserDb) DeleteCurrency(ctx context.Context, currency core.FullCurrency) error {
+	return u.withTx(ctx, func(ctx context.Context) error {
+		currencyId, err := u.CheckCurrency(ctx, currency)
+		if err != nil {
+			return err
+		}
+
+		db := u.dbDriver
+		tx, ok := u.transactionDB.ExtractTx(ctx)
+		if ok {
+			db = tx
+		}
+
+		// steps of pairs and opportunities are deleted by cascade, so pairs and opportunities are
+		// deleted explicitly to not keep them with missing steps
+		queries := []string{
+			`	DELETE FROM
+					converter_pairs
+				WHERE
+					id IN (
+						SELECT
+							converter_pair_id
+						FROM
+							converter_pair_steps
+						WHERE
+							currency_id = $1)`,
+			`	DELETE FROM
+					arbitrage_opportunities
+				WHERE
+					id IN (
+						SELECT
+							arbitrage_opportunity_id
+						FROM
+							arbitrage_opportunity_steps
+						WHERE
+							currency_id = $1)`,
+			`	DELETE FROM
+					currencies
+				WHERE
+					id = $1`,
+		}
+
+		for _, query := range queries {
+			if _, err := db.ExecContext(ctx, query, currencyId); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"query":    logQuery(query),
+					"currency": currency,
+					"error":    err,
+				}).Error("error delete currency")
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetCatalogCurrencies returns all currencies including disabled ones
+func (u *UserDb) GetCatalogCurrencies(ctx context.Context) ([]core.CatalogCurrency, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					type,
+					code,
+					bank_code,
+					disabled
+				FROM
+					currencies
+				ORDER BY
+					id`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error get catalog currencies")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var currencies []core.CatalogCurrency
+	for rows.Next() {
+		var currency core.CatalogCurrency
+		var currencyType string
+		if err := rows.Scan(&currencyType, &currency.Currency.CurrencyCode,
+			&currency.Currency.BankCode, &currency.Disabled); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query": logQuery(query),
+				"error": err,
+			}).Error("error scan catalog currency")
+			return nil, err
+		}
+		currency.Currency.CurrencyType, err = u.convertSqliteCurrencyTypeToCore(currencyType)
+		if err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, currency)
+	}
+	return currencies, rows.Err()
+}
+
+func (u *UserDb) SetConverterPairDisabled(ctx context.Context, converterPair core.ConverterPair,
+	disabled bool) error {
+	converterPairId, err := u.CheckConverterPair(ctx, converterPair)
+	if err != nil {
+		return err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	UPDATE
+					converter_pairs
+				SET
+					disabled = $2
+				WHERE
+					id = $1`
+
+	if _, err := db.ExecContext(ctx, query, converterPairId, disabled); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":           logQuery(query),
+			"converterPairId": converterPairId,
+			"disabled":        disabled,
+			"error":           err,
+		}).Error("error set converter pair disabled")
+		return err
+	}
+	return nil
+}
+
+// DeleteConverterPair deletes the converter pair with subscriptions of users to it
+func (u *UserDb) DeleteConverterPair(ctx context.Context, converterPair core.ConverterPair) error {
+	converterPairId, err := u.CheckConverterPair(ctx, converterPair)
+	if err != nil {
+		return err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	DELETE FROM
+					converter_pairs
+				WHERE
+					id = $1`
+
+	if _, err := db.ExecContext(ctx, query, converterPairId); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":           logQuery(query),
+			"converterPairId": converterPairId,
+			"error":           err,
+		}).Error("error delete converter pair")
+		return err
+	}
+	return nil
+}
+
+// GetCatalogConverterPairs returns all converter pairs including disabled ones
+func (u *UserDb) GetCatalogConverterPairs(ctx context.Context) ([]core.CatalogConverterPair,
+	error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					id,
+					disabled
+				FROM
+					converter_pairs
+				ORDER BY
+					id`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error get catalog converter pairs")
+		return nil, err
+	}
+
+	// rows are read before pairs are resolved, so pairs may be resolved on the same connection
+	var converterPairIds []int
+	var disabled []bool
+	for rows.Next() {
+		var converterPairId int
+		var pairDisabled bool
+		if err := rows.Scan(&converterPairId, &pairDisabled); err != nil {
+			rows.Close()
+			logrus.WithFields(logrus.Fields{
+				"query": logQuery(query),
+				"error": err,
+			}).Error("error scan catalog converter pair")
+			return nil, err
+		}
+		converterPairIds = append(converterPairIds, converterPairId)
+		disabled = append(disabled, pairDisabled)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	converterPairs, err := u.getConverterPairsByIds(ctx, converterPairIds)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := make([]core.CatalogConverterPair, 0, len(converterPairs))
+	for i, converterPair := range converterPairs {
+		catalog = append(catalog, core.CatalogConverterPair{
+			ConverterPair: converterPair,
+			Disabled:      disabled[i],
+		})
+	}
+	return catalog, nil
+}
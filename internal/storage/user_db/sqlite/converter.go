@@ -0,0 +1,673 @@
+package userDbSqlite
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"strconv"
+	"strings"
+)
+
+func (u *UserDb) AddConverterPair(ctx context.Context, converterPair core.ConverterPair) (int,
+	error) {
+
+	if len(converterPair.Currencies) < 2 {
+		return 0, core.ErrorConverterInvalidConverterPair
+	}
+
+	var converterPairId int
+	err := u.withTx(ctx, func(ctx context.Context) error {
+		var currencyIds []int
+		for _, currency := range converterPair.Currencies {
+			currencyId, err := u.AddCurrencyIfHasNot(ctx, currency)
+			if err != nil {
+				return err
+			}
+			currencyIds = append(currencyIds, currencyId)
+		}
+
+		db := u.dbDriver
+		tx, ok := u.transactionDB.ExtractTx(ctx)
+		if ok {
+			db = tx
+		}
+
+		query := `	INSERT INTO
+						converter_pairs
+						(level, route)
+					VALUES
+						($1, $2)
+					RETURNING
+						id`
+
+		row := db.QueryRowContext(ctx, query, len(currencyIds), converterPairRoute(currencyIds))
+		if err := row.Scan(&converterPairId); err != nil {
+			if isUniqueViolation(err) {
+				return core.ErrorConverterConverterPairAlreadyExists
+			}
+			logrus.WithFields(logrus.Fields{
+				"query":         logQuery(query),
+				"converterPair": converterPair,
+				"error":         err,
+			}).Error("error add converter pair")
+			return err
+		}
+
+		query = `	INSERT INTO
+						converter_pair_steps
+						(converter_pair_id, position, currency_id)
+					VALUES
+						($1, $2, $3)`
+
+		for position, currencyId := range currencyIds {
+			if _, err := db.ExecContext(ctx, query, converterPairId, position,
+				currencyId); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"query":           logQuery(query),
+					"converterPairId": converterPairId,
+					"currencyId":      currencyId,
+					"error":           err,
+				}).Error("error add step of converter pair")
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return converterPairId, nil
+}
+
+func (u *UserDb) CheckConverterPair(ctx context.Context, converterPair core.ConverterPair) (int,
+	error) {
+
+	if len(converterPair.Currencies) < 2 {
+		return 0, core.ErrorConverterInvalidConverterPair
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+	query := `	SELECT
+                    id
+                FROM
+                    converter_pairs
+                WHERE
+                    route = $1`
+
+	var currencyIds []int
+	for _, currency := range converterPair.Currencies {
+		currencyId, err := u.CheckCurrency(ctx, currency)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error":    err,
+				"currency": currency,
+			}).Error("error check currency")
+			return 0, core.ErrorConverterInvalidConverterPair
+		}
+		currencyIds = append(currencyIds, currencyId)
+	}
+
+	route := converterPairRoute(currencyIds)
+	row := db.QueryRowContext(ctx, query, route)
+	var converterPairId int
+	if err := row.Scan(&converterPairId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, core.ErrorConverterConverterPairNotFound
+		}
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"route": route,
+		}).Error("error scan converter pair id")
+		return 0, err
+	}
+	return converterPairId, nil
+}
+
+// availableConverterPairCondition selects converter pairs which and all currencies of which are
+// enabled
+const availableConverterPairCondition = `
+					NOT converter_pairs.disabled AND
+					NOT EXISTS (
+						SELECT
+							1
+						FROM
+							converter_pair_steps
+						JOIN
+							currencies ON currencies.id = converter_pair_steps.currency_id
+						WHERE
+							converter_pair_steps.converter_pair_id = converter_pairs.id AND
+							currencies.disabled
+					)`
+
+func (u *UserDb) GetConverterPairs(ctx context.Context) ([]core.ConverterPair, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+	    			id
+				FROM
+				    converter_pairs
+				WHERE` + availableConverterPairCondition + `
+				ORDER BY
+					id`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"query": logQuery(query),
+		}).Error("error run query on database")
+		return nil, err
+	}
+
+	converterPairIds, err := scanIds(rows)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"query": logQuery(query),
+		}).Error("error scan row")
+		return nil, err
+	}
+
+	return u.getConverterPairsByIds(ctx, converterPairIds)
+}
+
+func (u *UserDb) AddConverterPairIfHasNot(ctx context.Context,
+	converterPair core.ConverterPair) (int, error) {
+	id, err := u.CheckConverterPair(ctx, converterPair)
+	if err != nil {
+		switch err {
+		// CheckConverterPair reports unknown currencies of the pair as invalid pair, they are
+		// added with the pair
+		case core.ErrorConverterConverterPairNotFound, core.ErrorConverterInvalidConverterPair:
+			id, err = u.AddConverterPair(ctx, converterPair)
+			if err != nil {
+				return 0, err
+			}
+		default:
+			return 0, err
+		}
+	}
+	return id, nil
+}
+
+func (u *UserDb) SetUserConverterPair(ctx context.Context, userId int,
+	converterPair core.ConverterPair) (int, error) {
+	converterPairId, err := u.CheckConverterPair(ctx, converterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err.Error(),
+		}).Error("error check converter pair")
+		return 0, err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	// nothing is inserted when the pair is disabled
+	query := `	INSERT INTO 
+	    			user_converter_pairs
+					(user_id, converter_pair_id)
+				SELECT
+					$1, id
+				FROM
+					converter_pairs
+				WHERE
+					id = $2 AND` + availableConverterPairCondition + `
+				RETURNING 
+					id`
+
+	row := db.QueryRowContext(ctx, query, userId, converterPairId)
+
+	var userConverterPairId int
+	if err := row.Scan(&userConverterPairId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, core.ErrorConverterConverterPairDisabled
+		}
+		if isUniqueViolation(err) {
+			return 0, core.ErrorConverterConverterPairAlreadyExists
+		}
+		if isForeignKeyViolation(err) {
+			return 0, core.ErrorAuthServiceUserNotFound
+		}
+		logrus.WithFields(logrus.Fields{
+			"query":           logQuery(query),
+			"userId":          userId,
+			"converterPairId": converterPairId,
+			"error":           err,
+		}).Error("error set user converter pair")
+		return 0, err
+	}
+	return userConverterPairId, nil
+}
+
+func (u *UserDb) GetUserConverterPairs(ctx context.Context, userId int) ([]core.ConverterPair,
+	error) {
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+	    			id
+				FROM
+				    converter_pairs
+                WHERE
+                	id IN
+                		(SELECT 
+                		     converter_pair_id
+                		 FROM
+                		     user_converter_pairs
+                		 WHERE 
+                		     user_id = $1) AND` + availableConverterPairCondition + `
+				ORDER BY
+					id`
+
+	rows, err := db.QueryContext(ctx, query, userId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error run query when get user converter pair")
+		return nil, err
+	}
+
+	converterPairIds, err := scanIds(rows)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error scan row when get user converter pair")
+		return nil, err
+	}
+
+	return u.getConverterPairsByIds(ctx, converterPairIds)
+}
+
+func (u *UserDb) SetThresholdConvertPair(ctx context.Context, userId int,
+	threshold core.ThresholdConvertPair) error {
+	userConverterPairId, err := u.getUserConverterPairId(ctx, userId, threshold.ConverterPair)
+	if err != nil {
+		return err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	INSERT INTO
+					user_converter_pair_thresholds
+					(user_id, user_converter_pair_id, threshold)
+				VALUES
+					($1, $2, $3)
+				ON CONFLICT
+					(user_id, user_converter_pair_id, threshold)
+				DO NOTHING`
+
+	_, err = db.ExecContext(ctx, query, userId, userConverterPairId,
+		formatExchange(threshold.Exchange))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":               logQuery(query),
+			"userId":              userId,
+			"userConverterPairId": userConverterPairId,
+			"threshold":           threshold.Exchange,
+			"error":               err,
+		}).Error("error set threshold of converter pair")
+		return err
+	}
+
+	return nil
+}
+
+func (u *UserDb) DeleteThresholdConvertPair(ctx context.Context, userId int,
+	converterPair core.ConverterPair) error {
+	userConverterPairId, err := u.getUserConverterPairId(ctx, userId, converterPair)
+	if err != nil {
+		return err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	DELETE FROM
+					user_converter_pair_thresholds
+				WHERE
+					user_id = $1 AND
+					user_converter_pair_id = $2`
+
+	_, err = db.ExecContext(ctx, query, userId, userConverterPairId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":               logQuery(query),
+			"userId":              userId,
+			"userConverterPairId": userConverterPairId,
+			"error":               err,
+		}).Error("error delete thresholds of converter pair")
+		return err
+	}
+
+	return nil
+}
+
+// GetThresholdConvertPair returns thresholds of the user, thresholds are text columns, so they are
+// ordered by their numeric values
+func (u *UserDb) GetThresholdConvertPair(ctx context.Context,
+	userId int) ([]core.ThresholdConvertPair, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					ucp.converter_pair_id, t.threshold
+				FROM
+					user_converter_pair_thresholds t
+					JOIN user_converter_pairs ucp ON ucp.id = t.user_converter_pair_id
+				WHERE
+					t.user_id = $1
+				ORDER BY
+					ucp.converter_pair_id, CAST(t.threshold AS REAL)`
+
+	rows, err := db.QueryContext(ctx, query, userId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error run query when get thresholds of converter pairs")
+		return nil, err
+	}
+
+	type thresholdRow struct {
+		converterPairId int
+		threshold       core.Exchange
+	}
+
+	var thresholdRows []thresholdRow
+	for rows.Next() {
+		var row thresholdRow
+		if err := rows.Scan(&row.converterPairId, &row.threshold); err != nil {
+			rows.Close()
+			logrus.WithFields(logrus.Fields{
+				"query":  logQuery(query),
+				"userId": userId,
+				"error":  err,
+			}).Error("error scan row when get thresholds of converter pairs")
+			return nil, err
+		}
+		thresholdRows = append(thresholdRows, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var thresholds []core.ThresholdConvertPair
+	for _, row := range thresholdRows {
+		converterPair, err := u.getConverterPairById(ctx, row.converterPairId)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"userId": userId,
+				"error":  err,
+			}).Error("error get converter pair when get thresholds of converter pairs")
+			return nil, err
+		}
+		thresholds = append(thresholds, core.ThresholdConvertPair{
+			ConverterPair: converterPair,
+			Exchange:      row.threshold,
+		})
+	}
+
+	return thresholds, nil
+}
+
+func (u *UserDb) GetAllThresholdConvertPairs(ctx context.Context) ([]core.UserThresholdConvertPair,
+	error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					u.id, u.chat_id, ucp.converter_pair_id, t.threshold
+				FROM
+					user_converter_pair_thresholds t
+					JOIN users u ON u.id = t.user_id
+					JOIN user_converter_pairs ucp ON ucp.id = t.user_converter_pair_id
+				WHERE
+					u.chat_id IS NOT NULL
+				ORDER BY
+					ucp.converter_pair_id, u.id, CAST(t.threshold AS REAL)`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error run query when get all thresholds of converter pairs")
+		return nil, err
+	}
+
+	type thresholdRow struct {
+		userId          int
+		chatId          int64
+		converterPairId int
+		threshold       core.Exchange
+	}
+
+	var thresholdRows []thresholdRow
+	for rows.Next() {
+		var row thresholdRow
+		if err := rows.Scan(&row.userId, &row.chatId, &row.converterPairId,
+			&row.threshold); err != nil {
+			rows.Close()
+			logrus.WithFields(logrus.Fields{
+				"query": logQuery(query),
+				"error": err,
+			}).Error("error scan row when get all thresholds of converter pairs")
+			return nil, err
+		}
+		thresholdRows = append(thresholdRows, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var thresholds []core.UserThresholdConvertPair
+	for _, row := range thresholdRows {
+		converterPair, err := u.getConverterPairById(ctx, row.converterPairId)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"userId": row.userId,
+				"error":  err,
+			}).Error("error get converter pair when get all thresholds of converter pairs")
+			return nil, err
+		}
+		thresholds = append(thresholds, core.UserThresholdConvertPair{
+			UserId: row.userId,
+			ChatId: row.chatId,
+			Threshold: core.ThresholdConvertPair{
+				ConverterPair: converterPair,
+				Exchange:      row.threshold,
+			},
+		})
+	}
+
+	return thresholds, nil
+}
+
+func (u *UserDb) getUserConverterPairId(ctx context.Context, userId int,
+	converterPair core.ConverterPair) (int, error) {
+	converterPairId, err := u.CheckConverterPair(ctx, converterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err.Error(),
+		}).Error("error check converter pair")
+		return 0, err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+					id
+				FROM
+					user_converter_pairs
+				WHERE
+					user_id = $1 AND
+					converter_pair_id = $2`
+
+	var userConverterPairId int
+	err = db.QueryRowContext(ctx, query, userId, converterPairId).Scan(&userConverterPairId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, core.ErrorConverterConverterPairNotSubscribed
+		}
+		logrus.WithFields(logrus.Fields{
+			"query":           logQuery(query),
+			"userId":          userId,
+			"converterPairId": converterPairId,
+			"error":           err,
+		}).Error("error get user converter pair")
+		return 0, err
+	}
+
+	return userConverterPairId, nil
+}
+
+func (u *UserDb) getConverterPairById(ctx context.Context,
+	converterPairId int) (core.ConverterPair, error) {
+	query := `	SELECT
+					currency_id
+				FROM
+					converter_pair_steps
+				WHERE
+					converter_pair_id = $1
+				ORDER BY
+					position`
+
+	return u.getConverterPairBySteps(ctx, query, converterPairId)
+}
+
+// getConverterPairBySteps resolves currencies of steps selected by query of currency ids ordered by
+// position of steps of the owner
+func (u *UserDb) getConverterPairBySteps(ctx context.Context, query string,
+	ownerId interface{}) (core.ConverterPair, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	rows, err := db.QueryContext(ctx, query, ownerId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":   logQuery(query),
+			"ownerId": ownerId,
+			"error":   err,
+		}).Error("error run query when get steps")
+		return core.ConverterPair{}, err
+	}
+
+	currencyIds, err := scanIds(rows)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":   logQuery(query),
+			"ownerId": ownerId,
+			"error":   err,
+		}).Error("error scan row when get steps")
+		return core.ConverterPair{}, err
+	}
+
+	if len(currencyIds) < 2 {
+		return core.ConverterPair{}, core.ErrorConverterInvalidConverterPair
+	}
+
+	var converterPair core.ConverterPair
+	for _, id := range currencyIds {
+		currency, err := u.GetCurrency(ctx, id)
+		if err != nil {
+			return core.ConverterPair{}, err
+		}
+		converterPair.Currencies = append(converterPair.Currencies, *currency)
+	}
+	return converterPair, nil
+}
+
+func (u *UserDb) getConverterPairsByIds(ctx context.Context,
+	converterPairIds []int) ([]core.ConverterPair, error) {
+	var converterPairs []core.ConverterPair
+	for _, converterPairId := range converterPairIds {
+		converterPair, err := u.getConverterPairById(ctx, converterPairId)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error":           err,
+				"converterPairId": converterPairId,
+			}).Error("error get converter pair")
+			return nil, err
+		}
+		converterPairs = append(converterPairs, converterPair)
+	}
+	return converterPairs, nil
+}
+
+// scanIds reads ids of the first column and closes rows, so the rows may be resolved on the same
+// connection afterwards
+func scanIds(rows *sql.Rows) ([]int, error) {
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// converterPairRoute returns '/' separated currency ids of steps of converter pair, route is
+// unique for converter pair
+func converterPairRoute(currencyIds []int) string {
+	route := make([]string, 0, len(currencyIds))
+	for _, currencyId := range currencyIds {
+		route = append(route, strconv.Itoa(currencyId))
+	}
+	return strings.Join(route, "/")
+}
+
+func formatExchange(exchange core.Exchange) string {
+	return exchange.String()
+}
@@ -0,0 +1,403 @@
+package userDbSqlite
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func (u *UserDb) AddCurrency(ctx context.Context,
+	currency core.FullCurrency) (int, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `
+				INSERT INTO currencies
+				    (type, code, bank_code) 
+				VALUES 
+				    ($1, $2, $3) 
+				RETURNING 
+				    id
+				`
+
+	currencyType, err := u.convertCoreCurrencyTypeToSqlite(currency.CurrencyType)
+	if err != nil {
+		return 0, err
+	}
+
+	row := db.QueryRowContext(ctx, query, currencyType, currency.CurrencyCode, currency.BankCode)
+
+	var currencyId int
+	if err := row.Scan(&currencyId); err != nil {
+		if isUniqueViolation(err) {
+			return 0, core.ErrorCurrencyAlreadyHas
+		}
+		logrus.WithFields(logrus.Fields{
+			"query":    logQuery(query),
+			"currency": currency,
+			"error":    err,
+		}).Error("error add currency")
+		return 0, err
+	}
+
+	return currencyId, nil
+}
+
+func (u *UserDb) CheckCurrency(ctx context.Context,
+	currency core.FullCurrency) (int, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT 
+	    			id
+	    		FROM 
+	           		currencies
+				WHERE
+				    type = $1 AND
+				    code = $2 AND
+                    bank_code = $3`
+
+	currencyType, err := u.convertCoreCurrencyTypeToSqlite(currency.CurrencyType)
+	if err != nil {
+		return 0, err
+	}
+
+	row := db.QueryRowContext(ctx, query, currencyType, currency.CurrencyCode, currency.BankCode)
+
+	var currencyId int
+	if err := row.Scan(&currencyId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, core.ErrorCurrencyNotFound
+		}
+		logrus.WithFields(logrus.Fields{
+			"query":    logQuery(query),
+			"currency": currency,
+			"error":    err,
+		}).Error("error check currency")
+		return 0, err
+	}
+
+	return currencyId, nil
+}
+
+func (u *UserDb) GetCurrency(ctx context.Context, currencyId int) (*core.FullCurrency, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+	query := `	SELECT 
+                	type, code, bank_code
+                FROM 
+                    currencies
+                WHERE
+                    id = $1`
+
+	row := db.QueryRowContext(ctx, query, currencyId)
+
+	var currency core.FullCurrency
+	var currencyType string
+
+	if err := row.Scan(&currencyType, &currency.CurrencyCode, &currency.BankCode); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, core.ErrorCurrencyNotFound
+		}
+		logrus.WithFields(logrus.Fields{
+			"query":      logQuery(query),
+			"currencyId": currencyId,
+			"error":      err,
+		}).Error("error get currency")
+		return nil, err
+	}
+
+	var err error
+	currency.CurrencyType, err = u.convertSqliteCurrencyTypeToCore(currencyType)
+	if err != nil {
+		return nil, err
+	}
+	return &currency, nil
+}
+
+func (u *UserDb) AddCurrencyIfHasNot(ctx context.Context, currency core.FullCurrency) (int, error) {
+	id, err := u.CheckCurrency(ctx, currency)
+	if err != nil {
+		switch err {
+		case core.ErrorCurrencyNotFound:
+			id, err = u.AddCurrency(ctx, currency)
+			if err != nil {
+				return 0, err
+			}
+			break
+		default:
+			return 0, err
+		}
+	}
+	return id, nil
+}
+
+func (u *UserDb) GetAvailableClassicCurrencies(ctx context.Context) ([]core.CurrencyCode, error) {
+	query := `	SELECT DISTINCT
+                	code
+                FROM
+                    currencies
+                WHERE
+                    type = 'classic' AND
+                    NOT disabled`
+
+	return u.getCurrencyCodes(ctx, query)
+}
+
+func (u *UserDb) GetAvailableBanks(ctx context.Context, currency core.CurrencyCode) ([]core.
+	CurrencyBank, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT DISTINCT
+                	bank_code
+                FROM
+                    currencies
+                WHERE
+                    type = 'classic' AND
+                    code = $1 AND
+                    NOT disabled`
+
+	rows, err := db.QueryContext(ctx, query, currency)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":    logQuery(query),
+			"currency": currency,
+			"error":    err,
+		}).Error("error get available banks")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var banks []core.CurrencyBank
+
+	for rows.Next() {
+		var bank core.CurrencyBank
+		if err := rows.Scan(&bank); err != nil {
+			return nil, err
+		}
+		banks = append(banks, bank)
+	}
+	return banks, rows.Err()
+}
+
+func (u *UserDb) GetAvailableCryptoCurrencies(ctx context.Context) ([]core.CurrencyCode, error) {
+	query := `	SELECT
+                	code
+                FROM
+                    currencies
+                WHERE
+                    type = 'crypto' AND
+                    NOT disabled`
+
+	return u.getCurrencyCodes(ctx, query)
+}
+
+func (u *UserDb) getCurrencyCodes(ctx context.Context, query string) ([]core.CurrencyCode, error) {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"error": err,
+		}).Error("error get available currencies")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var currencies []core.CurrencyCode
+
+	for rows.Next() {
+		var currency core.CurrencyCode
+		if err := rows.Scan(&currency); err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, currency)
+	}
+	return currencies, rows.Err()
+}
+
+func (u *UserDb) AddUserCurrency(ctx context.Context, userId int,
+	currency core.FullCurrency) (int, error) {
+	var userCurrencyId int
+	err := u.withTx(ctx, func(ctx context.Context) error {
+		currencyId, err := u.AddCurrencyIfHasNot(ctx, currency)
+		if err != nil {
+			return err
+		}
+
+		db := u.dbDriver
+		tx, ok := u.transactionDB.ExtractTx(ctx)
+		if ok {
+			db = tx
+		}
+
+		query := `
+				INSERT INTO user_currencies
+				    (user_id, currency_id) 
+				VALUES 
+				    ($1, $2)
+				RETURNING
+                    id
+				`
+
+		row := db.QueryRowContext(ctx, query, userId, currencyId)
+		if err := row.Scan(&userCurrencyId); err != nil {
+			if isUniqueViolation(err) {
+				return core.ErrorCurrencyAlreadyHas
+			}
+			logrus.WithFields(logrus.Fields{
+				"query":    logQuery(query),
+				"userId":   userId,
+				"currency": currency,
+				"error":    err,
+			}).Error("error add user currency")
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return userCurrencyId, nil
+}
+
+// GetUserCurrencies returns currencies of the user in order they were added, all types are
+// returned when currencyType is nil
+func (u *UserDb) GetUserCurrencies(ctx context.Context, userId int,
+	currencyType *core.CurrencyType) ([]core.FullCurrency, error) {
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	SELECT
+   					c.type, c.code, c.bank_code
+				FROM
+    				user_currencies uc
+    				JOIN currencies c ON c.id = uc.currency_id
+				WHERE
+    				uc.user_id = $1`
+
+	args := []interface{}{userId}
+
+	if currencyType != nil {
+		sqliteCurrencyType, err := u.convertCoreCurrencyTypeToSqlite(*currencyType)
+		if err != nil {
+			return nil, err
+		}
+		query += " AND c.type = $2"
+		args = append(args, sqliteCurrencyType)
+	}
+	query += " ORDER BY uc.id"
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":  logQuery(query),
+			"userId": userId,
+			"error":  err,
+		}).Error("error run query when get user currencies")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var currencies []core.FullCurrency
+
+	for rows.Next() {
+		var currency core.FullCurrency
+		var currencyType string
+		if err := rows.Scan(&currencyType, &currency.CurrencyCode, &currency.BankCode); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query":  logQuery(query),
+				"userId": userId,
+				"error":  err,
+			}).Error("error scan row when get user currencies")
+			return nil, err
+		}
+		currency.CurrencyType, err = u.convertSqliteCurrencyTypeToCore(currencyType)
+		if err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, currency)
+	}
+	return currencies, rows.Err()
+}
+
+// DeleteUserCurrency deletes currencies of the user with the code of all banks
+func (u *UserDb) DeleteUserCurrency(ctx context.Context, userId int,
+	currency core.CurrencyCode) error {
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	DELETE FROM
+					user_currencies
+				WHERE
+					user_id = $1 AND
+					currency_id IN (
+						SELECT
+							id
+						FROM
+							currencies
+						WHERE
+							code = $2)`
+
+	result, err := db.ExecContext(ctx, query, userId, currency)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":    logQuery(query),
+			"userId":   userId,
+			"currency": currency,
+			"error":    err,
+		}).Error("error delete user currency")
+		return err
+	}
+	return requireAffected(result, core.ErrorCurrencyNotFound)
+}
+
+func (u *UserDb) convertCoreCurrencyTypeToSqlite(currencyType core.CurrencyType) (string, error) {
+	switch currencyType {
+	case core.CurrencyTypeCrypto:
+		return "crypto", nil
+	case core.CurrencyTypeClassic:
+		return "classic", nil
+	default:
+		return "", core.ErrorCurrencyInvalidCurrencyType
+	}
+}
+
+func (u *UserDb) convertSqliteCurrencyTypeToCore(currencyType string) (core.CurrencyType, error) {
+	switch currencyType {
+	case "crypto":
+		return core.CurrencyTypeCrypto, nil
+	case "classic":
+		return core.CurrencyTypeClassic, nil
+	default:
+		return 0, core.ErrorCurrencyInvalidCurrencyType
+	}
+}
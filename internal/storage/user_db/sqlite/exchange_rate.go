@@ -0,0 +1,145 @@
+package userDbSqlite
+
+import (
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (u *UserDb) AddExchangeRate(ctx context.Context, rate core.ExchangeRate) (int64, error) {
+	converterPairId, err := u.CheckConverterPair(ctx, rate.ConverterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": rate.ConverterPair,
+			"error":         err.Error(),
+		}).Error("error check converter pair")
+		return 0, err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	query := `	INSERT INTO
+					exchange_rates
+					(converter_pair_id, rate, sampled_at, source)
+				VALUES
+					($1, $2, $3, $4)
+				RETURNING
+					id`
+
+	var exchangeRateId int64
+	err = db.QueryRowContext(ctx, query, converterPairId, formatExchange(rate.Exchange),
+		rate.SampledAt.UTC(), rate.Source).Scan(&exchangeRateId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query": logQuery(query),
+			"rate":  rate,
+			"error": err,
+		}).Error("error add exchange rate")
+		return 0, err
+	}
+
+	return exchangeRateId, nil
+}
+
+func (u *UserDb) GetExchangeRates(ctx context.Context, converterPair core.ConverterPair,
+	interval core.TimeInterval) ([]core.ExchangeRate, error) {
+	converterPairId, err := u.CheckConverterPair(ctx, converterPair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"error":         err.Error(),
+		}).Error("error check converter pair")
+		return nil, err
+	}
+
+	db := u.dbDriver
+	tx, ok := u.transactionDB.ExtractTx(ctx)
+	if ok {
+		db = tx
+	}
+
+	// times are stored in UTC in sortable text format, so they are compared as text
+	query := `	SELECT
+					rate, sampled_at, source
+				FROM
+					exchange_rates
+				WHERE
+					converter_pair_id = $1 AND
+					sampled_at >= $2 AND
+					sampled_at <= $3
+				ORDER BY
+					sampled_at, id`
+
+	rows, err := db.QueryContext(ctx, query, converterPairId, interval.Start().UTC(),
+		interval.End().UTC())
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"query":           logQuery(query),
+			"converterPairId": converterPairId,
+			"error":           err,
+		}).Error("error run query when get exchange rates")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []core.ExchangeRate
+	for rows.Next() {
+		rate := core.ExchangeRate{
+			ConverterPair: converterPair,
+		}
+		if err := rows.Scan(&rate.Exchange, &rate.SampledAt, &rate.Source); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"query":           logQuery(query),
+				"converterPairId": converterPairId,
+				"error":           err,
+			}).Error("error scan row when get exchange rates")
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, rows.Err()
+}
+
+// GetExchangeRateCandles groups rates of the interval into candles of timeframe aligned to unix
+// epoch, sqlite has no ordered aggregates, so rates are grouped after they are read
+func (u *UserDb) GetExchangeRateCandles(ctx context.Context, converterPair core.ConverterPair,
+	timeframe time.Duration, interval core.TimeInterval) ([]core.Candle, error) {
+	seconds := int64(timeframe / time.Second)
+	if seconds <= 0 {
+		return nil, core.ErrorCandleInvalidTimeframe
+	}
+
+	rates, err := u.GetExchangeRates(ctx, converterPair, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	var candles []core.Candle
+	for _, rate := range rates {
+		openTime := time.Unix(rate.SampledAt.Unix()/seconds*seconds, 0)
+		if len(candles) == 0 || !candles[len(candles)-1].OpenTime.Equal(openTime) {
+			candles = append(candles, core.Candle{
+				OpenTime: openTime,
+				Open:     rate.Exchange,
+				High:     rate.Exchange,
+				Low:      rate.Exchange,
+			})
+		}
+		candle := &candles[len(candles)-1]
+		if rate.Exchange.GreaterThan(candle.High) {
+			candle.High = rate.Exchange
+		}
+		if rate.Exchange.LessThan(candle.Low) {
+			candle.Low = rate.Exchange
+		}
+		candle.Close = rate.Exchange
+		candle.SampleCount++
+	}
+	return candles, nil
+}
@@ -0,0 +1,111 @@
+package userDbSqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	connectionTimeout = 5 * time.Second
+	busyTimeout       = 5 * time.Second
+)
+
+type Config struct {
+	// Path of the database file, it is created when it does not exist
+	Path string `json:"path"`
+}
+
+// NewSqliteDB opens the database file. Foreign keys are enabled on every connection, write
+// transactions take the database lock immediately, so concurrent writers wait for busy timeout
+// instead of failing on lock upgrade
+func NewSqliteDB(ctx context.Context, cfg Config) (*sql.DB, error) {
+	logBase := logrus.Fields{
+		"module":   "sqlite",
+		"file":     "sqlite.go",
+		"function": "NewSqliteDB",
+	}
+
+	params := url.Values{}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeout.Milliseconds()))
+	params.Set("_txlock", "immediate")
+	// times are compared as text, so they are kept in one sortable format
+	params.Set("_time_format", "sqlite")
+	dsn := fmt.Sprintf("file:%s?%s", cfg.Path, params.Encode())
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"base":  logBase,
+			"error": err,
+		}).Error("error open db")
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, connectionTimeout)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		logrus.WithFields(logrus.Fields{
+			"base":  logBase,
+			"error": err,
+		}).Error("error ping db")
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"base": logBase,
+		"path": cfg.Path,
+	}).Info("database inited")
+
+	return db, nil
+}
+
+func logQuery(query string) string {
+	query = strings.ReplaceAll(query, "\n", "")
+	return strings.ReplaceAll(query, "\t", "")
+}
+
+// isUniqueViolation reports violation of unique constraint or primary key
+func isUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	switch sqliteErr.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		return true
+	default:
+		return false
+	}
+}
+
+// isForeignKeyViolation reports a reference to a missing row
+func isForeignKeyViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY
+}
+
+// requireAffected returns notFound when the statement changed no rows
+func requireAffected(result sql.Result, notFound error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
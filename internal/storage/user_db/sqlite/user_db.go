@@ -0,0 +1,50 @@
+package userDbSqlite
+
+import (
+	"database/sql"
+	"golang.org/x/net/context"
+)
+
+type dbDriverUserDB interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+type transactionDBUserDB interface {
+	InjectTx(ctx context.Context) (context.Context, error)
+	ExtractTx(ctx context.Context) (*sql.Tx, bool)
+	CommitTx(ctx context.Context) error
+	RollbackTxDefer(ctx context.Context)
+}
+
+type UserDb struct {
+	dbDriver      dbDriverUserDB
+	transactionDB transactionDBUserDB
+}
+
+func NewUserDB(dbDriver dbDriverUserDB, transactionDB transactionDBUserDB) *UserDb {
+	return &UserDb{
+		dbDriver:      dbDriver,
+		transactionDB: transactionDB,
+	}
+}
+
+// withTx runs fn in the transaction of ctx, a new transaction is started when ctx has none. It is
+// used by writes of several statements which postgres does in one statement
+func (u *UserDb) withTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := u.transactionDB.ExtractTx(ctx); ok {
+		return fn(ctx)
+	}
+
+	txCtx, err := u.transactionDB.InjectTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer u.transactionDB.RollbackTxDefer(txCtx)
+
+	if err := fn(txCtx); err != nil {
+		return err
+	}
+	return u.transactionDB.CommitTx(txCtx)
+}
@@ -0,0 +1,73 @@
+package userDbSqlite
+
+import (
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/binance-converter/backend/internal/storage/storagetest"
+	sqliteSchema "github.com/binance-converter/backend/schema/sqlite"
+	"golang.org/x/net/context"
+	"path/filepath"
+	"testing"
+)
+
+// TestUserDbConformance runs the suite against a new database file for every test
+func TestUserDbConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.UserDb {
+		ctx := context.Background()
+
+		db, err := NewSqliteDB(ctx, Config{Path: filepath.Join(t.TempDir(), "user.db")})
+		if err != nil {
+			t.Fatalf("open sqlite: %v", err)
+		}
+		t.Cleanup(func() { _ = db.Close() })
+
+		migrator, err := NewMigrator(db, sqliteSchema.Migrations)
+		if err != nil {
+			t.Fatalf("load migrations: %v", err)
+		}
+		if _, err := migrator.Up(ctx); err != nil {
+			t.Fatalf("apply migrations: %v", err)
+		}
+
+		return NewUserDB(db, NewTransaction(db))
+	})
+}
+
+// TestMigratorUnversionedSchema checks that a database migrated by hand is not migrated again from
+// the first version until its version is recorded as the baseline
+func TestMigratorUnversionedSchema(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := NewSqliteDB(ctx, Config{Path: filepath.Join(t.TempDir(), "user.db")})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	first, err := sqliteSchema.Migrations.ReadFile("000001_init.up.sql")
+	if err != nil {
+		t.Fatalf("read first migration: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, string(first)); err != nil {
+		t.Fatalf("apply first migration by hand: %v", err)
+	}
+
+	migrator, err := NewMigrator(db, sqliteSchema.Migrations)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	if _, err := migrator.Up(ctx); !errors.Is(err, core.ErrorMigrationUnversionedSchema) {
+		t.Fatalf("up without baseline: got %v, want %v", err, core.ErrorMigrationUnversionedSchema)
+	}
+
+	if err := migrator.Baseline(ctx, 1); err != nil {
+		t.Fatalf("record baseline: %v", err)
+	}
+	versions, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("up after baseline: %v", err)
+	}
+	if len(versions) == 0 || versions[0] != 2 {
+		t.Fatalf("applied versions: got %v, want versions after the baseline", versions)
+	}
+}
@@ -0,0 +1,368 @@
+package handler
+
+import (
+	"github.com/binance-converter/backend-api/api/converter"
+	"github.com/binance-converter/backend-api/api/currencies"
+	"github.com/binance-converter/backend/api/admin"
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type feesService interface {
+	SetFeeSchedule(ctx context.Context, schedule core.FeeSchedule) error
+	DeleteFeeSchedule(ctx context.Context, scope core.FeeScope, key string) error
+	GetFeeSchedules(ctx context.Context) ([]core.FeeSchedule, error)
+}
+
+type catalogService interface {
+	AddCurrency(ctx context.Context, currency core.FullCurrency) error
+	SetCurrencyDisabled(ctx context.Context, currency core.FullCurrency, disabled bool) error
+	DeleteCurrency(ctx context.Context, currency core.FullCurrency) error
+	GetCurrencies(ctx context.Context) ([]core.CatalogCurrency, error)
+	AddConverterPair(ctx context.Context, converterPair core.ConverterPair) error
+	SetConverterPairDisabled(ctx context.Context, converterPair core.ConverterPair,
+		disabled bool) error
+	DeleteConverterPair(ctx context.Context, converterPair core.ConverterPair) error
+	GetConverterPairs(ctx context.Context) ([]core.CatalogConverterPair, error)
+}
+
+type AdminHandler struct {
+	admin.UnimplementedAdminServer
+	fees    feesService
+	catalog catalogService
+}
+
+func NewAdminHandler(fees feesService, catalog catalogService) *AdminHandler {
+	return &AdminHandler{fees: fees, catalog: catalog}
+}
+
+func (a *AdminHandler) SetFeeSchedule(ctx context.Context,
+	schedule *admin.FeeSchedule) (*emptypb.Empty, error) {
+	coreSchedule, err := convertProtoFeeScheduleToCore(schedule)
+	if err != nil {
+		return nil, convertFeeErrorToStatus(err)
+	}
+
+	if err := a.fees.SetFeeSchedule(ctx, coreSchedule); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"schedule": coreSchedule,
+			"error":    err.Error(),
+		}).Error("error set fee schedule")
+		return nil, convertFeeErrorToStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AdminHandler) DeleteFeeSchedule(ctx context.Context,
+	key *admin.FeeScheduleKey) (*emptypb.Empty, error) {
+	scope, err := convertProtoFeeScheduleKeyToCore(key)
+	if err != nil {
+		return nil, convertFeeErrorToStatus(err)
+	}
+
+	if err := a.fees.DeleteFeeSchedule(ctx, scope, key.Key); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"scope": scope,
+			"key":   key.Key,
+			"error": err.Error(),
+		}).Error("error delete fee schedule")
+		return nil, convertFeeErrorToStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AdminHandler) GetFeeSchedules(ctx context.Context,
+	empty *emptypb.Empty) (*admin.FeeSchedules, error) {
+	schedules, err := a.fees.GetFeeSchedules(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error get fee schedules")
+		return nil, convertFeeErrorToStatus(err)
+	}
+
+	protoSchedules := &admin.FeeSchedules{}
+	for _, schedule := range schedules {
+		scope := admin.EFeeScope_BANK
+		if schedule.Scope == core.FeeScopeProvider {
+			scope = admin.EFeeScope_PROVIDER
+		}
+		protoSchedules.Schedules = append(protoSchedules.Schedules, &admin.FeeSchedule{
+			Key: &admin.FeeScheduleKey{
+				Scope: scope,
+				Key:   schedule.Key,
+			},
+			Fixed:          schedule.Fixed.InexactFloat64(),
+			Percent:        schedule.Percent.InexactFloat64(),
+			FixedDecimal:   schedule.Fixed.String(),
+			PercentDecimal: schedule.Percent.String(),
+			Spread:         schedule.Spread.InexactFloat64(),
+			SpreadDecimal:  schedule.Spread.String(),
+		})
+	}
+	return protoSchedules, nil
+}
+
+func (a *AdminHandler) AddCurrency(ctx context.Context,
+	currency *currencies.FullCurrency) (*emptypb.Empty, error) {
+	coreCurrency, err := convertProtoFullCurrencyToCore(currency)
+	if err != nil {
+		return nil, convertCatalogErrorToStatus(err)
+	}
+
+	if err := a.catalog.AddCurrency(ctx, coreCurrency); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"currency": coreCurrency,
+			"error":    err.Error(),
+		}).Error("error add currency")
+		return nil, convertCatalogErrorToStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AdminHandler) SetCurrencyDisabled(ctx context.Context,
+	currency *admin.CatalogCurrency) (*emptypb.Empty, error) {
+	coreCurrency, err := convertProtoFullCurrencyToCore(currency.GetCurrency())
+	if err != nil {
+		return nil, convertCatalogErrorToStatus(err)
+	}
+
+	if err := a.catalog.SetCurrencyDisabled(ctx, coreCurrency, currency.Disabled); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"currency": coreCurrency,
+			"disabled": currency.Disabled,
+			"error":    err.Error(),
+		}).Error("error set currency disabled")
+		return nil, convertCatalogErrorToStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AdminHandler) DeleteCurrency(ctx context.Context,
+	currency *currencies.FullCurrency) (*emptypb.Empty, error) {
+	coreCurrency, err := convertProtoFullCurrencyToCore(currency)
+	if err != nil {
+		return nil, convertCatalogErrorToStatus(err)
+	}
+
+	if err := a.catalog.DeleteCurrency(ctx, coreCurrency); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"currency": coreCurrency,
+			"error":    err.Error(),
+		}).Error("error delete currency")
+		return nil, convertCatalogErrorToStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AdminHandler) GetCurrencies(ctx context.Context,
+	empty *emptypb.Empty) (*admin.CatalogCurrencies, error) {
+	coreCurrencies, err := a.catalog.GetCurrencies(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error get catalog currencies")
+		return nil, convertCatalogErrorToStatus(err)
+	}
+
+	protoCurrencies := &admin.CatalogCurrencies{}
+	for _, currency := range coreCurrencies {
+		protoCurrency, err := convertCoreFullCurrencyToProto(currency.Currency)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		protoCurrencies.Currencies = append(protoCurrencies.Currencies, &admin.CatalogCurrency{
+			Currency: protoCurrency,
+			Disabled: currency.Disabled,
+		})
+	}
+	return protoCurrencies, nil
+}
+
+func (a *AdminHandler) AddConverterPair(ctx context.Context,
+	pair *converter.ConverterPair) (*emptypb.Empty, error) {
+	corePair, err := convertProtoConverterPairToCore(pair)
+	if err != nil {
+		return nil, convertCatalogErrorToStatus(err)
+	}
+
+	if err := a.catalog.AddConverterPair(ctx, corePair); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"corePair": corePair,
+			"error":    err.Error(),
+		}).Error("error add converter pair")
+		return nil, convertCatalogErrorToStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AdminHandler) SetConverterPairDisabled(ctx context.Context,
+	pair *admin.CatalogConverterPair) (*emptypb.Empty, error) {
+	corePair, err := convertProtoConverterPairToCore(pair.GetPair())
+	if err != nil {
+		return nil, convertCatalogErrorToStatus(err)
+	}
+
+	if err := a.catalog.SetConverterPairDisabled(ctx, corePair, pair.Disabled); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"corePair": corePair,
+			"disabled": pair.Disabled,
+			"error":    err.Error(),
+		}).Error("error set converter pair disabled")
+		return nil, convertCatalogErrorToStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AdminHandler) DeleteConverterPair(ctx context.Context,
+	pair *converter.ConverterPair) (*emptypb.Empty, error) {
+	corePair, err := convertProtoConverterPairToCore(pair)
+	if err != nil {
+		return nil, convertCatalogErrorToStatus(err)
+	}
+
+	if err := a.catalog.DeleteConverterPair(ctx, corePair); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"corePair": corePair,
+			"error":    err.Error(),
+		}).Error("error delete converter pair")
+		return nil, convertCatalogErrorToStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AdminHandler) GetConverterPairs(ctx context.Context,
+	empty *emptypb.Empty) (*admin.CatalogConverterPairs, error) {
+	corePairs, err := a.catalog.GetConverterPairs(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error get catalog converter pairs")
+		return nil, convertCatalogErrorToStatus(err)
+	}
+
+	protoPairs := &admin.CatalogConverterPairs{}
+	for _, pair := range corePairs {
+		protoPair, err := convertCoreConverterPairToProto(pair.ConverterPair)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		protoPairs.Pairs = append(protoPairs.Pairs, &admin.CatalogConverterPair{
+			Pair:     protoPair,
+			Disabled: pair.Disabled,
+		})
+	}
+	return protoPairs, nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// helper functions
+
+func convertProtoFeeScheduleKeyToCore(key *admin.FeeScheduleKey) (core.FeeScope, error) {
+	if key == nil {
+		return 0, core.ErrorFeeEmptyInputArg
+	}
+	switch key.Scope {
+	case admin.EFeeScope_BANK:
+		return core.FeeScopeBank, nil
+	case admin.EFeeScope_PROVIDER:
+		return core.FeeScopeProvider, nil
+	}
+	return 0, core.ErrorFeeInvalidScope
+}
+
+func convertProtoFeeScheduleToCore(schedule *admin.FeeSchedule) (core.FeeSchedule, error) {
+	if schedule == nil {
+		return core.FeeSchedule{}, core.ErrorFeeEmptyInputArg
+	}
+	scope, err := convertProtoFeeScheduleKeyToCore(schedule.Key)
+	if err != nil {
+		return core.FeeSchedule{}, err
+	}
+	fixed, err := convertProtoDecimalToCore(schedule.FixedDecimal, schedule.Fixed)
+	if err != nil {
+		return core.FeeSchedule{}, err
+	}
+	percent, err := convertProtoDecimalToCore(schedule.PercentDecimal, schedule.Percent)
+	if err != nil {
+		return core.FeeSchedule{}, err
+	}
+	spread, err := convertProtoDecimalToCore(schedule.SpreadDecimal, schedule.Spread)
+	if err != nil {
+		return core.FeeSchedule{}, err
+	}
+	return core.FeeSchedule{
+		Scope:   scope,
+		Key:     schedule.Key.Key,
+		Fixed:   fixed,
+		Percent: percent,
+		Spread:  spread,
+	}, nil
+}
+
+// convertProtoDecimalToCore prefers exact decimal value and falls back to float one when it is not
+// set
+func convertProtoDecimalToCore(exact string, value float64) (decimal.Decimal, error) {
+	if exact == "" {
+		return decimal.NewFromFloat(value), nil
+	}
+	d, err := decimal.NewFromString(exact)
+	if err != nil {
+		return decimal.Decimal{}, core.ErrorFeeInvalidFee
+	}
+	return d, nil
+}
+
+func convertFeeErrorToStatus(err error) error {
+	switch err {
+	case core.ErrorFeeEmptyInputArg:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case core.ErrorAdminNotAuthorized, core.ErrorAdminPermissionDenied:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case core.ErrorFeeInvalidScope:
+		return status.Error(codes.Code(admin.AdditionalErrorCode_INVALID_FEE_SCOPE), err.Error())
+	case core.ErrorFeeInvalidKey:
+		return status.Error(codes.Code(admin.AdditionalErrorCode_INVALID_FEE_KEY), err.Error())
+	case core.ErrorFeeInvalidFee:
+		return status.Error(codes.Code(admin.AdditionalErrorCode_INVALID_FEE), err.Error())
+	case core.ErrorFeeNotFound:
+		return status.Error(codes.Code(admin.AdditionalErrorCode_FEE_NOT_FOUND), err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func convertCatalogErrorToStatus(err error) error {
+	switch err {
+	case core.ErrorCurrencyEmptyInputArg, core.ErrorConverterEmptyInputArg:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case core.ErrorAdminNotAuthorized, core.ErrorAdminPermissionDenied:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case core.ErrorCurrencyInvalidCurrencyType, core.ErrorCurrencyInvalidCurrencyCode,
+		core.ErrorCurrencyInvalidBankCode:
+		return status.Error(codes.Code(admin.AdditionalErrorCode_INVALID_CURRENCY), err.Error())
+	case core.ErrorCurrencyNotFound:
+		return status.Error(codes.Code(admin.AdditionalErrorCode_CURRENCY_NOT_FOUND), err.Error())
+	case core.ErrorConverterInvalidConverterPair:
+		return status.Error(codes.Code(admin.AdditionalErrorCode_INVALID_CONVERTER_PAIR),
+			err.Error())
+	case core.ErrorConverterConverterPairNotFound:
+		return status.Error(codes.Code(admin.AdditionalErrorCode_CONVERTER_PAIR_NOT_FOUND),
+			err.Error())
+	case core.ErrorCatalogPairNotQuotable:
+		return status.Error(codes.Code(admin.AdditionalErrorCode_CONVERTER_PAIR_NOT_QUOTABLE),
+			err.Error())
+	case core.ErrorBinanceApiRateLimited:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case core.ErrorBinanceApiUnavailable, core.ErrorProviderUnavailable,
+		core.ErrorProviderNoProviders:
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+	"github.com/binance-converter/backend/api/alerts"
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+)
+
+type alertsService interface {
+	Subscribe(ctx context.Context, afterOffset int64,
+		send func(alert core.ThresholdAlert) error) error
+}
+
+type AlertsHandler struct {
+	alerts.UnimplementedAlertsServer
+	service alertsService
+}
+
+func NewAlertsHandler(service alertsService) *AlertsHandler {
+	return &AlertsHandler{service: service}
+}
+
+func (a *AlertsHandler) SubscribeAlerts(request *alerts.SubscribeAlertsRequest,
+	stream alerts.Alerts_SubscribeAlertsServer) error {
+	if request == nil {
+		return status.Error(codes.InvalidArgument, core.ErrorAlertEmptyInputArg.Error())
+	}
+	if request.Offset > math.MaxInt64 {
+		return status.Error(codes.Code(alerts.AdditionalErrorCode_INVALID_OFFSET),
+			core.ErrorAlertInvalidOffset.Error())
+	}
+
+	err := a.service.Subscribe(stream.Context(), int64(request.Offset),
+		func(alert core.ThresholdAlert) error {
+			protoAlert, err := convertCoreThresholdAlertToProto(alert)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"alert": alert,
+					"error": err.Error(),
+				}).Error("error convert core threshold alert to proto")
+				return err
+			}
+			return stream.Send(protoAlert)
+		})
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return status.FromContextError(err).Err()
+		}
+		logrus.WithFields(logrus.Fields{
+			"offset": request.Offset,
+			"error":  err.Error(),
+		}).Error("error subscribe alerts")
+		switch err {
+		case core.ErrorAlertInvalidOffset:
+			return status.Error(codes.Code(alerts.AdditionalErrorCode_INVALID_OFFSET), err.Error())
+		case core.ErrorAlertSubscriberSlow:
+			return status.Error(codes.Unavailable, err.Error())
+		case core.ErrorAdminNotAuthorized, core.ErrorAdminPermissionDenied:
+			return status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// helper functions
+
+func convertCoreAlertDirectionToProto(direction core.AlertDirection) (alerts.EAlertDirection,
+	error) {
+	switch direction {
+	case core.AlertDirectionUp:
+		return alerts.EAlertDirection_UP, nil
+	case core.AlertDirectionDown:
+		return alerts.EAlertDirection_DOWN, nil
+	}
+	return 0, core.ErrorAlertInvalidDirection
+}
+
+func convertCoreThresholdAlertToProto(alert core.ThresholdAlert) (*alerts.Alert, error) {
+	converterPair, err := convertCoreConverterPairToProto(alert.ConverterPair)
+	if err != nil {
+		return nil, err
+	}
+
+	direction, err := convertCoreAlertDirectionToProto(alert.Direction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &alerts.Alert{
+		Offset:        uint64(alert.Offset),
+		ChatId:        alert.ChatId,
+		ConverterPair: converterPair,
+		OldExchange:   convertCoreExchangeToProto(alert.OldExchange),
+		NewExchange:   convertCoreExchangeToProto(alert.NewExchange),
+		Threshold:     convertCoreExchangeToProto(alert.Threshold),
+		Direction:     direction,
+		CreatedAt:     timestamppb.New(alert.CreatedAt),
+
+		OldExchangeDecimal: alert.OldExchange.String(),
+		NewExchangeDecimal: alert.NewExchange.String(),
+		ThresholdDecimal:   alert.Threshold.String(),
+	}, nil
+}
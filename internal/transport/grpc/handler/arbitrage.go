@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"github.com/binance-converter/backend/api/arbitrage"
+	"github.com/binance-converter/backend/core"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+)
+
+type arbitrageService interface {
+	GetOpportunities(ctx context.Context, afterOffset int64,
+		limit int) ([]core.ArbitrageOpportunity, error)
+	SetSubscription(ctx context.Context, enabled bool) error
+	GetSubscribers(ctx context.Context) ([]core.ArbitrageSubscriber, error)
+}
+
+type ArbitrageHandler struct {
+	arbitrage.UnimplementedArbitrageServer
+	service arbitrageService
+}
+
+func NewArbitrageHandler(service arbitrageService) *ArbitrageHandler {
+	return &ArbitrageHandler{service: service}
+}
+
+func (a *ArbitrageHandler) GetArbitrageOpportunities(ctx context.Context,
+	request *arbitrage.OpportunitiesRequest) (*arbitrage.Opportunities, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, core.ErrorArbitrageEmptyInputArg.Error())
+	}
+	if request.Offset > math.MaxInt64 {
+		return nil, status.Error(codes.Code(arbitrage.AdditionalErrorCode_INVALID_OFFSET),
+			core.ErrorArbitrageInvalidOffset.Error())
+	}
+
+	opportunities, err := a.service.GetOpportunities(ctx, int64(request.Offset),
+		int(request.Limit))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"offset": request.Offset,
+			"limit":  request.Limit,
+			"error":  err.Error(),
+		}).Error("error get arbitrage opportunities")
+		switch err {
+		case core.ErrorArbitrageInvalidOffset:
+			return nil, status.Error(codes.Code(arbitrage.AdditionalErrorCode_INVALID_OFFSET),
+				err.Error())
+		case core.ErrorArbitrageInvalidLimit:
+			return nil, status.Error(codes.Code(arbitrage.AdditionalErrorCode_INVALID_LIMIT),
+				err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	protoOpportunities, err := convertCoreArbitrageOpportunitiesToProto(opportunities)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error convert core arbitrage opportunities to proto")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return protoOpportunities, nil
+}
+
+func (a *ArbitrageHandler) SetArbitrageSubscription(ctx context.Context,
+	subscription *arbitrage.Subscription) (*emptypb.Empty, error) {
+	if subscription == nil {
+		return nil, status.Error(codes.InvalidArgument, core.ErrorArbitrageEmptyInputArg.Error())
+	}
+
+	err := a.service.SetSubscription(ctx, subscription.Enabled)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"enabled": subscription.Enabled,
+			"error":   err.Error(),
+		}).Error("error set arbitrage subscription")
+		switch err {
+		case core.ErrorArbitrageNotAuthorized:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *ArbitrageHandler) GetArbitrageSubscribers(ctx context.Context,
+	empty *emptypb.Empty) (*arbitrage.Subscribers, error) {
+	subscribers, err := a.service.GetSubscribers(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error get arbitrage subscribers")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	protoSubscribers := &arbitrage.Subscribers{}
+	for _, subscriber := range subscribers {
+		protoSubscribers.ChatIds = append(protoSubscribers.ChatIds, subscriber.ChatId)
+	}
+	return protoSubscribers, nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// helper functions
+
+func convertCoreArbitrageOpportunityToProto(
+	opportunity core.ArbitrageOpportunity) (*arbitrage.Opportunity, error) {
+	cycle, err := convertCoreConverterPairToProto(opportunity.ConverterPair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &arbitrage.Opportunity{
+		Offset:         uint64(opportunity.Offset),
+		Cycle:          cycle,
+		Rate:           opportunity.Rate.InexactFloat64(),
+		NetRate:        opportunity.NetRate.InexactFloat64(),
+		DetectedAt:     timestamppb.New(opportunity.DetectedAt),
+		RateDecimal:    opportunity.Rate.String(),
+		NetRateDecimal: opportunity.NetRate.String(),
+	}, nil
+}
+
+func convertCoreArbitrageOpportunitiesToProto(
+	opportunities []core.ArbitrageOpportunity) (*arbitrage.Opportunities, error) {
+	protoOpportunities := &arbitrage.Opportunities{}
+	for _, opportunity := range opportunities {
+		protoOpportunity, err := convertCoreArbitrageOpportunityToProto(opportunity)
+		if err != nil {
+			return nil, err
+		}
+		protoOpportunities.Opportunities = append(protoOpportunities.Opportunities,
+			protoOpportunity)
+	}
+	return protoOpportunities, nil
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"github.com/binance-converter/backend-api/api/converter"
 	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -79,6 +80,8 @@ func (c ConverterHandler) SetConvertPair(ctx context.Context,
 		case core.ErrorConverterInvalidConverterPair:
 			return nil, status.Error(codes.Code(
 				converter.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		case core.ErrorConverterConverterPairDisabled:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -116,12 +119,21 @@ func (c ConverterHandler) SetThresholdConvertPairs(ctx context.Context,
 	}
 	err = c.service.SetThresholdConvertPair(ctx, corePair)
 	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error":    err.Error(),
+			"corePair": corePair,
+		}).Error("error set threshold of converter pair")
 		switch err {
 		case core.ErrorConverterNotAuthorized:
 			return nil, status.Error(codes.PermissionDenied, err.Error())
 		case core.ErrorConverterInvalidConverterPair:
 			return nil, status.Error(codes.Code(
 				converter.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		case core.ErrorConverterInvalidThreshold:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case core.ErrorConverterConverterPairNotFound,
+			core.ErrorConverterConverterPairNotSubscribed:
+			return nil, status.Error(codes.NotFound, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -163,9 +175,19 @@ func (c ConverterHandler) GetCurrentExchange(ctx context.Context,
 		switch err {
 		case core.ErrorConverterNotAuthorized:
 			return nil, status.Error(codes.PermissionDenied, err.Error())
-		case core.ErrorConverterInvalidConverterPair:
+		case core.ErrorConverterInvalidConverterPair, core.ErrorBinanceApiInvalidConverterPair:
 			return nil, status.Error(codes.Code(
 				converter.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		case core.ErrorConverterInvalidAmount, core.ErrorConverterInvalidTradeSide,
+			core.ErrorConverterAmountTooSmall:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case core.ErrorBinanceApiNoOffers, core.ErrorProviderNoOffers:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case core.ErrorBinanceApiRateLimited:
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case core.ErrorBinanceApiUnavailable, core.ErrorProviderUnavailable,
+			core.ErrorProviderNoProviders:
+			return nil, status.Error(codes.Unavailable, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -221,7 +243,7 @@ func convertProtoConverterPairToCore(protoConverterPair *converter.ConverterPair
 
 func convertCoreExchangeToProto(coreExchange core.Exchange) *converter.Exchange {
 	return &converter.Exchange{
-		Exchange: float32(coreExchange),
+		Exchange: float32(coreExchange.InexactFloat64()),
 	}
 }
 
@@ -253,14 +275,17 @@ func convertCoreThresholdConverterPairsToProto(coreThreshold []core.ThresholdCon
 
 func convertProtoExchangeToCore(protoExchange *converter.Exchange) (core.Exchange, error) {
 	if protoExchange == nil {
-		return core.Exchange(0), core.ErrorConverterEmptyInputArg
+		return core.Exchange{}, core.ErrorConverterEmptyInputArg
 	}
-	return core.Exchange(protoExchange.Exchange), nil
+	return decimal.NewFromFloat32(protoExchange.Exchange), nil
 }
 
 func convertProtoThresholdConverterPair(protoThreshold *converter.ThresholdConvertPair) (core.
 	ThresholdConvertPair, error) {
 	coreThreshold := core.ThresholdConvertPair{}
+	if protoThreshold == nil {
+		return coreThreshold, core.ErrorConverterEmptyInputArg
+	}
 
 	var err error
 
@@ -0,0 +1,832 @@
+package handler
+
+import (
+	"github.com/binance-converter/backend-api/api/converter"
+	"github.com/binance-converter/backend/api/converter_ext"
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type candlesService interface {
+	GetCandles(ctx context.Context, params core.CandleParams) ([]core.Candle, error)
+}
+
+type exchangeService interface {
+	GetCurrentExchange(ctx context.Context, converterPair core.ConverterPair) (core.Exchange, error)
+	QuoteRoute(ctx context.Context, converterPair core.ConverterPair) (core.RouteQuote, error)
+	QuoteRouteForTarget(ctx context.Context, converterPair core.ConverterPair,
+		target decimal.Decimal) (core.RouteQuote, error)
+	GetOffers(ctx context.Context, converterPair core.ConverterPair, limit int) ([]core.Offer,
+		error)
+	SetAdvertiserFilter(ctx context.Context, filter core.AdvertiserFilter) error
+	GetAdvertiserFilter(ctx context.Context) (core.AdvertiserFilter, error)
+}
+
+type routeService interface {
+	FindRoutes(ctx context.Context, params core.RouteParams) ([]core.RouteQuote, error)
+}
+
+type depthService interface {
+	GetDepthQuote(ctx context.Context, converterPair core.ConverterPair) (core.DepthQuote, error)
+}
+
+type ConverterExtHandler struct {
+	converter_ext.UnimplementedConverterExtServer
+	candles  candlesService
+	exchange exchangeService
+	routes   routeService
+	depth    depthService
+}
+
+func NewConverterExtHandler(candles candlesService, exchange exchangeService,
+	routes routeService, depth depthService) *ConverterExtHandler {
+	return &ConverterExtHandler{candles: candles, exchange: exchange, routes: routes, depth: depth}
+}
+
+func (c *ConverterExtHandler) GetCandles(ctx context.Context,
+	request *converter_ext.CandlesRequest) (*converter_ext.Candles, error) {
+	params, err := convertProtoCandlesRequestToCore(request)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"request": request,
+			"error":   err.Error(),
+		}).Error("error convert proto candles request to core")
+		switch err {
+		case core.ErrorCandleEmptyInputArg, core.ErrorExchangePlotEmptyInputArg:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case core.ErrorExchangePlotInvalidTimeInterval:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TIME_INTERVAL), err.Error())
+		case core.ErrorCandleInvalidTimeframe:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_CANDLE_TIMEFRAME), err.Error())
+		default:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		}
+	}
+
+	candles, err := c.candles.GetCandles(ctx, params)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"params": params,
+			"error":  err.Error(),
+		}).Error("error get candles")
+		switch err {
+		case core.ErrorCandleInvalidConverterPair:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		case core.ErrorCandleInvalidTimeInterval:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TIME_INTERVAL), err.Error())
+		case core.ErrorCandleInvalidTimeframe:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_CANDLE_TIMEFRAME), err.Error())
+		case core.ErrorCandleTooManyCandles:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case core.ErrorCandleConverterPairNotSupported:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_NOT_SUPPORTED_CONVERTER_PAIR), err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return convertCoreCandlesToProto(candles), nil
+}
+
+func (c *ConverterExtHandler) GetExchangeForAmount(ctx context.Context,
+	request *converter_ext.ExchangeRequest) (*converter.Exchange, error) {
+	exchange, err := c.getExchangeForAmount(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return convertCoreExchangeToProto(exchange), nil
+}
+
+func (c *ConverterExtHandler) GetDecimalExchangeForAmount(ctx context.Context,
+	request *converter_ext.ExchangeRequest) (*converter_ext.DecimalExchange, error) {
+	exchange, err := c.getExchangeForAmount(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &converter_ext.DecimalExchange{
+		Exchange:        float32(exchange.InexactFloat64()),
+		ExchangeDecimal: exchange.String(),
+	}, nil
+}
+
+func (c *ConverterExtHandler) GetOffers(ctx context.Context,
+	request *converter_ext.OffersRequest) (*converter_ext.Offers, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, core.ErrorConverterEmptyInputArg.Error())
+	}
+
+	corePair, err := convertProtoExchangeRequestToCore(&converter_ext.ExchangeRequest{
+		Pair:          request.Pair,
+		Amount:        request.Amount,
+		AmountDecimal: request.AmountDecimal,
+		Side:          request.Side,
+		Providers:     request.Providers,
+	})
+	if err != nil {
+		switch err {
+		case core.ErrorConverterInvalidTradeSide:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TRADE_SIDE), err.Error())
+		default:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	offers, err := c.exchange.GetOffers(ctx, corePair, int(request.Limit))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"corePair": corePair,
+			"limit":    request.Limit,
+			"error":    err.Error(),
+		}).Error("error get offers")
+		switch err {
+		case core.ErrorConverterInvalidConverterPair, core.ErrorBinanceApiInvalidConverterPair:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		case core.ErrorConverterInvalidAmount:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_AMOUNT), err.Error())
+		case core.ErrorOfferInvalidLimit:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_LIMIT), err.Error())
+		case core.ErrorProviderNotFound:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_PROVIDER_NOT_FOUND), err.Error())
+		case core.ErrorBinanceApiNoOffers, core.ErrorProviderNoOffers:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case core.ErrorBinanceApiRateLimited:
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case core.ErrorBinanceApiUnavailable, core.ErrorProviderUnavailable,
+			core.ErrorProviderNoProviders:
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return convertCoreOffersToProto(offers), nil
+}
+
+func (c *ConverterExtHandler) FindRoutes(ctx context.Context,
+	request *converter_ext.RoutesRequest) (*converter_ext.Routes, error) {
+	params, err := convertProtoRoutesRequestToCore(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	quotes, err := c.routes.FindRoutes(ctx, params)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"params": params,
+			"error":  err.Error(),
+		}).Error("error find routes")
+		switch err {
+		case core.ErrorRouteInvalidTarget:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case core.ErrorRouteInvalidMaxLegs:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_MAX_LEGS), err.Error())
+		case core.ErrorRouteInvalidLimit:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_LIMIT), err.Error())
+		case core.ErrorConverterInvalidAmount:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_AMOUNT), err.Error())
+		case core.ErrorConverterAmountTooSmall:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_AMOUNT_TOO_SMALL), err.Error())
+		case core.ErrorRouteNotFound:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_ROUTE_NOT_FOUND), err.Error())
+		case core.ErrorProviderNotFound:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_PROVIDER_NOT_FOUND), err.Error())
+		case core.ErrorBinanceApiNoOffers, core.ErrorProviderNoOffers:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case core.ErrorBinanceApiRateLimited:
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case core.ErrorBinanceApiUnavailable, core.ErrorProviderUnavailable,
+			core.ErrorProviderNoProviders:
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	routes, err := convertCoreRouteQuotesToProto(quotes)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error convert core routes to proto")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return routes, nil
+}
+
+func (c *ConverterExtHandler) QuoteRoute(ctx context.Context,
+	request *converter_ext.ExchangeRequest) (*converter_ext.Route, error) {
+	corePair, err := convertProtoExchangeRequestToCore(request)
+	if err != nil {
+		switch err {
+		case core.ErrorConverterInvalidTradeSide:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TRADE_SIDE), err.Error())
+		default:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	quote, err := c.exchange.QuoteRoute(ctx, corePair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"corePair": corePair,
+			"error":    err.Error(),
+		}).Error("error quote route")
+		switch err {
+		case core.ErrorConverterInvalidConverterPair, core.ErrorBinanceApiInvalidConverterPair:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		case core.ErrorConverterInvalidAmount:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_AMOUNT), err.Error())
+		case core.ErrorConverterAmountTooSmall:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_AMOUNT_TOO_SMALL), err.Error())
+		case core.ErrorConverterInvalidTradeSide:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TRADE_SIDE), err.Error())
+		case core.ErrorProviderNotFound:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_PROVIDER_NOT_FOUND), err.Error())
+		case core.ErrorBinanceApiNoOffers, core.ErrorProviderNoOffers:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case core.ErrorBinanceApiRateLimited:
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case core.ErrorBinanceApiUnavailable, core.ErrorProviderUnavailable,
+			core.ErrorProviderNoProviders:
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	route, err := convertCoreRouteQuoteToProto(quote)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error convert core route to proto")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return route, nil
+}
+
+func (c *ConverterExtHandler) GetRequiredAmount(ctx context.Context,
+	request *converter_ext.RequiredAmountRequest) (*converter_ext.RequiredAmount, error) {
+	corePair, target, err := convertProtoRequiredAmountRequestToCore(request)
+	if err != nil {
+		switch err {
+		case core.ErrorConverterInvalidTradeSide:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TRADE_SIDE), err.Error())
+		case core.ErrorConverterInvalidAmount:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_AMOUNT), err.Error())
+		default:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	quote, err := c.exchange.QuoteRouteForTarget(ctx, corePair, target)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"corePair": corePair,
+			"target":   target,
+			"error":    err.Error(),
+		}).Error("error get required amount")
+		switch err {
+		case core.ErrorConverterInvalidConverterPair, core.ErrorBinanceApiInvalidConverterPair:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		case core.ErrorConverterInvalidAmount:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_AMOUNT), err.Error())
+		case core.ErrorConverterTargetNotReachable:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_TARGET_NOT_REACHABLE), err.Error())
+		case core.ErrorConverterInvalidTradeSide:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TRADE_SIDE), err.Error())
+		case core.ErrorProviderNotFound:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_PROVIDER_NOT_FOUND), err.Error())
+		case core.ErrorBinanceApiNoOffers, core.ErrorProviderNoOffers:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case core.ErrorBinanceApiRateLimited:
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case core.ErrorBinanceApiUnavailable, core.ErrorProviderUnavailable,
+			core.ErrorProviderNoProviders:
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	route, err := convertCoreRouteQuoteToProto(quote)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error convert core route to proto")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	amount := quote.Legs[0].AmountIn
+	return &converter_ext.RequiredAmount{
+		Amount:        amount.InexactFloat64(),
+		AmountDecimal: amount.String(),
+		Route:         route,
+	}, nil
+}
+
+func (c *ConverterExtHandler) GetDepthQuote(ctx context.Context,
+	request *converter_ext.ExchangeRequest) (*converter_ext.DepthQuote, error) {
+	corePair, err := convertProtoExchangeRequestToCore(request)
+	if err != nil {
+		switch err {
+		case core.ErrorConverterInvalidTradeSide:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TRADE_SIDE), err.Error())
+		default:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	quote, err := c.depth.GetDepthQuote(ctx, corePair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"corePair": corePair,
+			"error":    err.Error(),
+		}).Error("error get depth quote")
+		switch err {
+		case core.ErrorConverterInvalidConverterPair, core.ErrorBinanceApiInvalidConverterPair:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		case core.ErrorDepthInvalidAmount:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_AMOUNT), err.Error())
+		case core.ErrorDepthInsufficientLiquidity:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INSUFFICIENT_DEPTH), err.Error())
+		case core.ErrorBinanceApiInvalidTradeSide:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TRADE_SIDE), err.Error())
+		case core.ErrorProviderNotFound:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_PROVIDER_NOT_FOUND), err.Error())
+		case core.ErrorBinanceApiNoOffers:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case core.ErrorBinanceApiRateLimited:
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case core.ErrorBinanceApiUnavailable:
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	depthQuote, err := convertCoreDepthQuoteToProto(quote)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error convert core depth quote to proto")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return depthQuote, nil
+}
+
+func (c *ConverterExtHandler) SetAdvertiserFilter(ctx context.Context,
+	request *converter_ext.AdvertiserFilter) (*emptypb.Empty, error) {
+	filter := convertProtoAdvertiserFilterToCore(request)
+	if err := c.exchange.SetAdvertiserFilter(ctx, filter); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"filter": filter,
+			"error":  err.Error(),
+		}).Error("error set advertiser filter")
+		switch err {
+		case core.ErrorConverterNotAuthorized:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case core.ErrorAdvertiserFilterInvalidCompletionRate,
+			core.ErrorAdvertiserFilterInvalidOrderCount,
+			core.ErrorAdvertiserFilterInvalidAdvertiser:
+			return nil, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_ADVERTISER_FILTER), err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (c *ConverterExtHandler) GetAdvertiserFilter(ctx context.Context,
+	_ *emptypb.Empty) (*converter_ext.AdvertiserFilter, error) {
+	filter, err := c.exchange.GetAdvertiserFilter(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error get advertiser filter")
+		switch err {
+		case core.ErrorConverterNotAuthorized:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return convertCoreAdvertiserFilterToProto(filter), nil
+}
+
+// getExchangeForAmount returns exchange for amount of request or status error
+func (c *ConverterExtHandler) getExchangeForAmount(ctx context.Context,
+	request *converter_ext.ExchangeRequest) (core.Exchange, error) {
+	corePair, err := convertProtoExchangeRequestToCore(request)
+	if err != nil {
+		switch err {
+		case core.ErrorConverterInvalidTradeSide:
+			return core.Exchange{}, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TRADE_SIDE), err.Error())
+		default:
+			return core.Exchange{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	exchange, err := c.exchange.GetCurrentExchange(ctx, corePair)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"corePair": corePair,
+			"error":    err.Error(),
+		}).Error("error get exchange for amount")
+		switch err {
+		case core.ErrorConverterInvalidConverterPair, core.ErrorBinanceApiInvalidConverterPair:
+			return core.Exchange{}, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		case core.ErrorConverterInvalidAmount:
+			return core.Exchange{}, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_AMOUNT), err.Error())
+		case core.ErrorConverterAmountTooSmall:
+			return core.Exchange{}, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_AMOUNT_TOO_SMALL), err.Error())
+		case core.ErrorConverterInvalidTradeSide:
+			return core.Exchange{}, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_INVALID_TRADE_SIDE), err.Error())
+		case core.ErrorProviderNotFound:
+			return core.Exchange{}, status.Error(codes.Code(
+				converter_ext.AdditionalErrorCode_PROVIDER_NOT_FOUND), err.Error())
+		case core.ErrorBinanceApiNoOffers, core.ErrorProviderNoOffers:
+			return core.Exchange{}, status.Error(codes.NotFound, err.Error())
+		case core.ErrorBinanceApiRateLimited:
+			return core.Exchange{}, status.Error(codes.ResourceExhausted, err.Error())
+		case core.ErrorBinanceApiUnavailable, core.ErrorProviderUnavailable,
+			core.ErrorProviderNoProviders:
+			return core.Exchange{}, status.Error(codes.Unavailable, err.Error())
+		default:
+			return core.Exchange{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return exchange, nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// helper functions
+
+func convertProtoAdvertiserFilterToCore(
+	filter *converter_ext.AdvertiserFilter) core.AdvertiserFilter {
+	return core.AdvertiserFilter{
+		MerchantOnly:        filter.MerchantOnly,
+		MinCompletionRate:   filter.MinCompletionRate,
+		MinOrderCount:       int(filter.MinOrderCount),
+		ExcludedAdvertisers: filter.ExcludedAdvertisers,
+	}
+}
+
+func convertCoreAdvertiserFilterToProto(
+	filter core.AdvertiserFilter) *converter_ext.AdvertiserFilter {
+	return &converter_ext.AdvertiserFilter{
+		MerchantOnly:        filter.MerchantOnly,
+		MinCompletionRate:   filter.MinCompletionRate,
+		MinOrderCount:       uint32(filter.MinOrderCount),
+		ExcludedAdvertisers: filter.ExcludedAdvertisers,
+	}
+}
+
+func convertProtoTradeSideToCore(side converter_ext.ETradeSide) (core.TradeSide, error) {
+	switch side {
+	case converter_ext.ETradeSide_AUTO:
+		return core.TradeSideAuto, nil
+	case converter_ext.ETradeSide_BUY:
+		return core.TradeSideBuy, nil
+	case converter_ext.ETradeSide_SELL:
+		return core.TradeSideSell, nil
+	}
+	return 0, core.ErrorConverterInvalidTradeSide
+}
+
+func convertProtoRequiredAmountRequestToCore(request *converter_ext.RequiredAmountRequest) (
+	core.ConverterPair, decimal.Decimal, error) {
+	if request == nil {
+		return core.ConverterPair{}, decimal.Decimal{}, core.ErrorConverterEmptyInputArg
+	}
+
+	converterPair, err := convertProtoExchangeRequestToCore(&converter_ext.ExchangeRequest{
+		Pair:      request.Pair,
+		Side:      request.Side,
+		Providers: request.Providers,
+	})
+	if err != nil {
+		return core.ConverterPair{}, decimal.Decimal{}, err
+	}
+
+	target, err := convertProtoAmountToCore(request.TargetAmountDecimal, request.TargetAmount)
+	if err != nil {
+		return core.ConverterPair{}, decimal.Decimal{}, err
+	}
+	if !target.IsPositive() {
+		return core.ConverterPair{}, decimal.Decimal{}, core.ErrorConverterInvalidAmount
+	}
+
+	return converterPair, target, nil
+}
+
+// convertProtoAmountToCore prefers exact decimal amount and falls back to float one when it is not
+// set
+func convertProtoAmountToCore(exact string, value float64) (decimal.Decimal, error) {
+	if exact == "" {
+		return decimal.NewFromFloat(value), nil
+	}
+	amount, err := decimal.NewFromString(exact)
+	if err != nil {
+		return decimal.Decimal{}, core.ErrorConverterInvalidAmount
+	}
+	return amount, nil
+}
+
+func convertProtoExchangeRequestToCore(request *converter_ext.ExchangeRequest) (
+	core.ConverterPair, error) {
+	if request == nil {
+		return core.ConverterPair{}, core.ErrorConverterEmptyInputArg
+	}
+
+	converterPair, err := convertProtoConverterPairToCore(request.Pair)
+	if err != nil {
+		return core.ConverterPair{}, err
+	}
+
+	converterPair.TradeSide, err = convertProtoTradeSideToCore(request.Side)
+	if err != nil {
+		return core.ConverterPair{}, err
+	}
+	converterPair.Amount, err = convertProtoAmountToCore(request.AmountDecimal, request.Amount)
+	if err != nil {
+		return core.ConverterPair{}, err
+	}
+	for _, provider := range request.Providers {
+		converterPair.Providers = append(converterPair.Providers, core.ProviderName(provider))
+	}
+
+	return converterPair, nil
+}
+
+func convertProtoCandleTimeframeToCore(timeframe converter_ext.ECandleTimeframe) (
+	core.CandleTimeframe, error) {
+	switch timeframe {
+	case converter_ext.ECandleTimeframe_ONE_MINUTE:
+		return core.CandleTimeframeOneMinute, nil
+	case converter_ext.ECandleTimeframe_FIVE_MINUTES:
+		return core.CandleTimeframeFiveMinutes, nil
+	case converter_ext.ECandleTimeframe_ONE_HOUR:
+		return core.CandleTimeframeOneHour, nil
+	case converter_ext.ECandleTimeframe_ONE_DAY:
+		return core.CandleTimeframeOneDay, nil
+	}
+	return 0, core.ErrorCandleInvalidTimeframe
+}
+
+func convertProtoCandlesRequestToCore(request *converter_ext.CandlesRequest) (core.CandleParams,
+	error) {
+	if request == nil {
+		return core.CandleParams{}, core.ErrorCandleEmptyInputArg
+	}
+
+	converterPair, err := convertProtoConverterPairToCore(request.Pair)
+	if err != nil {
+		return core.CandleParams{}, err
+	}
+
+	timeframe, err := convertProtoCandleTimeframeToCore(request.Timeframe)
+	if err != nil {
+		return core.CandleParams{}, err
+	}
+
+	interval, err := convertProtoTimeIntervalToCore(request.Interval)
+	if err != nil {
+		return core.CandleParams{}, err
+	}
+
+	return core.CandleParams{
+		ConverterPair: converterPair,
+		Timeframe:     timeframe,
+		TimeInterval:  interval,
+	}, nil
+}
+
+func convertCoreCandleToProto(candle core.Candle) *converter_ext.Candle {
+	return &converter_ext.Candle{
+		OpenTime: timestamppb.New(candle.OpenTime),
+		Open:     convertCoreExchangeToProto(candle.Open),
+		High:     convertCoreExchangeToProto(candle.High),
+		Low:      convertCoreExchangeToProto(candle.Low),
+		Close:    convertCoreExchangeToProto(candle.Close),
+
+		OpenDecimal:  candle.Open.String(),
+		HighDecimal:  candle.High.String(),
+		LowDecimal:   candle.Low.String(),
+		CloseDecimal: candle.Close.String(),
+		SampleCount:  uint32(candle.SampleCount),
+	}
+}
+
+func convertCoreCandlesToProto(candles []core.Candle) *converter_ext.Candles {
+	protoCandles := &converter_ext.Candles{}
+	for _, candle := range candles {
+		protoCandles.Candles = append(protoCandles.Candles, convertCoreCandleToProto(candle))
+	}
+	return protoCandles
+}
+
+func convertCoreOfferToProto(offer core.Offer) *converter_ext.Offer {
+	return &converter_ext.Offer{
+		AdvNo: offer.AdvNo,
+		Advertiser: &converter_ext.Advertiser{
+			UserNo:          offer.Advertiser.UserNo,
+			NickName:        offer.Advertiser.NickName,
+			Merchant:        offer.Advertiser.IsMerchant,
+			MonthOrderCount: uint32(offer.Advertiser.MonthOrderCount),
+			CompletionRate:  offer.Advertiser.CompletionRate,
+		},
+		Exchange:                 convertCoreExchangeToProto(offer.Exchange),
+		ExchangeDecimal:          offer.Exchange.String(),
+		MinAmount:                offer.MinAmount.InexactFloat64(),
+		MaxAmount:                offer.MaxAmount.InexactFloat64(),
+		AvailableQuantity:        offer.AvailableQuantity.InexactFloat64(),
+		PayMethods:               offer.PayMethods,
+		Provider:                 string(offer.Provider),
+		MinAmountDecimal:         offer.MinAmount.String(),
+		MaxAmountDecimal:         offer.MaxAmount.String(),
+		AvailableQuantityDecimal: offer.AvailableQuantity.String(),
+	}
+}
+
+func convertCoreOffersToProto(offers []core.Offer) *converter_ext.Offers {
+	protoOffers := &converter_ext.Offers{}
+	for _, offer := range offers {
+		protoOffers.Offers = append(protoOffers.Offers, convertCoreOfferToProto(offer))
+	}
+	return protoOffers
+}
+
+func convertProtoRoutesRequestToCore(request *converter_ext.RoutesRequest) (core.RouteParams,
+	error) {
+	if request == nil {
+		return core.RouteParams{}, core.ErrorRouteEmptyInputArg
+	}
+
+	source, err := convertProtoFullCurrencyToCore(request.Source)
+	if err != nil {
+		return core.RouteParams{}, err
+	}
+	target, err := convertProtoFullCurrencyToCore(request.Target)
+	if err != nil {
+		return core.RouteParams{}, err
+	}
+
+	amount, err := convertProtoAmountToCore(request.AmountDecimal, request.Amount)
+	if err != nil {
+		return core.RouteParams{}, err
+	}
+
+	params := core.RouteParams{
+		Source:  source,
+		Target:  target,
+		Amount:  amount,
+		MaxLegs: int(request.MaxLegs),
+		Limit:   int(request.Limit),
+	}
+	for _, provider := range request.Providers {
+		params.Providers = append(params.Providers, core.ProviderName(provider))
+	}
+	return params, nil
+}
+
+func convertCoreRouteQuoteToProto(quote core.RouteQuote) (*converter_ext.Route, error) {
+	pair, err := convertCoreConverterPairToProto(quote.ConverterPair)
+	if err != nil {
+		return nil, err
+	}
+	route := &converter_ext.Route{
+		Pair:            pair,
+		Exchange:        convertCoreExchangeToProto(quote.Exchange),
+		Rate:            quote.Rate.InexactFloat64(),
+		ExchangeDecimal: quote.Exchange.String(),
+		RateDecimal:     quote.Rate.String(),
+		Spot:            quote.Spot,
+	}
+	for _, leg := range quote.Legs {
+		legPair, err := convertCoreConverterPairToProto(leg.ConverterPair)
+		if err != nil {
+			return nil, err
+		}
+		route.Legs = append(route.Legs, &converter_ext.RouteLeg{
+			Pair:             legPair,
+			Exchange:         convertCoreExchangeToProto(leg.Exchange),
+			Rate:             leg.Rate.InexactFloat64(),
+			AmountIn:         leg.AmountIn.InexactFloat64(),
+			AmountOut:        leg.AmountOut.InexactFloat64(),
+			Provider:         string(leg.Provider),
+			Fees:             convertCoreAppliedFeesToProto(leg.Fees),
+			ExchangeDecimal:  leg.Exchange.String(),
+			RateDecimal:      leg.Rate.String(),
+			AmountInDecimal:  leg.AmountIn.String(),
+			AmountOutDecimal: leg.AmountOut.String(),
+		})
+	}
+	return route, nil
+}
+
+func convertCoreRouteQuotesToProto(quotes []core.RouteQuote) (*converter_ext.Routes, error) {
+	routes := &converter_ext.Routes{}
+	for _, quote := range quotes {
+		route, err := convertCoreRouteQuoteToProto(quote)
+		if err != nil {
+			return nil, err
+		}
+		routes.Routes = append(routes.Routes, route)
+	}
+	return routes, nil
+}
+
+func convertCoreAppliedFeesToProto(fees []core.AppliedFee) []*converter_ext.Fee {
+	protoFees := make([]*converter_ext.Fee, 0, len(fees))
+	for _, fee := range fees {
+		scope := converter_ext.EFeeScope_BANK
+		if fee.Scope == core.FeeScopeProvider {
+			scope = converter_ext.EFeeScope_PROVIDER
+		}
+		protoFees = append(protoFees, &converter_ext.Fee{
+			Scope:         scope,
+			Key:           fee.Key,
+			Amount:        fee.Amount.InexactFloat64(),
+			AmountDecimal: fee.Amount.String(),
+		})
+	}
+	return protoFees
+}
+
+func convertCoreDepthQuoteToProto(quote core.DepthQuote) (*converter_ext.DepthQuote, error) {
+	pair, err := convertCoreConverterPairToProto(quote.ConverterPair)
+	if err != nil {
+		return nil, err
+	}
+	depthQuote := &converter_ext.DepthQuote{
+		Pair:                   pair,
+		Exchange:               convertCoreExchangeToProto(quote.Exchange),
+		BestExchange:           convertCoreExchangeToProto(quote.BestExchange),
+		SlippagePercent:        quote.SlippagePercent.InexactFloat64(),
+		AmountIn:               quote.AmountIn.InexactFloat64(),
+		AmountOut:              quote.AmountOut.InexactFloat64(),
+		ExchangeDecimal:        quote.Exchange.String(),
+		BestExchangeDecimal:    quote.BestExchange.String(),
+		SlippagePercentDecimal: quote.SlippagePercent.String(),
+		AmountInDecimal:        quote.AmountIn.String(),
+		AmountOutDecimal:       quote.AmountOut.String(),
+	}
+	for _, fill := range quote.Fills {
+		depthQuote.Fills = append(depthQuote.Fills, &converter_ext.DepthFill{
+			Offer:            convertCoreOfferToProto(fill.Offer),
+			AmountIn:         fill.AmountIn.InexactFloat64(),
+			AmountOut:        fill.AmountOut.InexactFloat64(),
+			AmountInDecimal:  fill.AmountIn.String(),
+			AmountOutDecimal: fill.AmountOut.String(),
+		})
+	}
+	return depthQuote, nil
+}
@@ -30,8 +30,16 @@ func (e *ExchangePlotHandler) GetExchangePlot(ctx context.Context,
 	params *exchange_plot.PlotParams) (*exchange_plot.Plot, error) {
 	corePlotParams, err := convertProtoPlotParamsToCore(params)
 	if err != nil {
-		return nil, status.Error(codes.Code(
-			exchange_plot.AdditionalErrorCode_INVALID_TIME_INTERVAL), err.Error())
+		switch err {
+		case core.ErrorExchangePlotEmptyInputArg:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case core.ErrorExchangePlotInvalidTimeInterval:
+			return nil, status.Error(codes.Code(
+				exchange_plot.AdditionalErrorCode_INVALID_TIME_INTERVAL), err.Error())
+		default:
+			return nil, status.Error(codes.Code(
+				exchange_plot.AdditionalErrorCode_INVALID_CONVERTER_PAIR), err.Error())
+		}
 	}
 
 	plot, err := e.service.GetExchangePlot(ctx, corePlotParams)
@@ -64,10 +72,17 @@ func (e *ExchangePlotHandler) GetExchangePlot(ctx context.Context,
 
 func convertProtoTimeIntervalToCore(protoTimeInterval *exchange_plot.TimeInterval) (core.
 	TimeInterval, error) {
-	start, err := timeInterval.New(protoTimeInterval.Start.AsTime(),
-		protoTimeInterval.Start.AsTime())
+	if protoTimeInterval == nil || protoTimeInterval.Start == nil || protoTimeInterval.End == nil {
+		return core.TimeInterval{}, core.ErrorExchangePlotEmptyInputArg
+	}
+
+	interval, err := timeInterval.New(protoTimeInterval.Start.AsTime(),
+		protoTimeInterval.End.AsTime())
+	if err != nil {
+		return core.TimeInterval{}, core.ErrorExchangePlotInvalidTimeInterval
+	}
 
-	return core.TimeInterval(start), err
+	return core.TimeInterval(interval), nil
 }
 
 func convertProtoPlotParamsToCore(protoPlotParams *exchange_plot.PlotParams) (core.PlotParams,
@@ -6,6 +6,10 @@ import (
 	"github.com/binance-converter/backend-api/api/converter"
 	"github.com/binance-converter/backend-api/api/currencies"
 	"github.com/binance-converter/backend-api/api/exchange_plot"
+	"github.com/binance-converter/backend/api/admin"
+	"github.com/binance-converter/backend/api/alerts"
+	"github.com/binance-converter/backend/api/arbitrage"
+	"github.com/binance-converter/backend/api/converter_ext"
 	"github.com/binance-converter/backend/core"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
@@ -33,13 +37,19 @@ type Server struct {
 	converter    converter.ConverterServer
 	currencies   currencies.CurrenciesServer
 	exchangePlot exchange_plot.ExchangePlotServer
+	alerts       alerts.AlertsServer
+	converterExt converter_ext.ConverterExtServer
+	arbitrage    arbitrage.ArbitrageServer
+	admin        admin.AdminServer
 
 	srv *grpc.Server
 }
 
 func NewServer(logger *logrus.Logger, auth auth.AuthServer,
 	converter converter.ConverterServer, currencies currencies.CurrenciesServer,
-	exchangePlot exchange_plot.ExchangePlotServer, authService AuthService) *Server {
+	exchangePlot exchange_plot.ExchangePlotServer, alerts alerts.AlertsServer,
+	converterExt converter_ext.ConverterExtServer, arbitrage arbitrage.ArbitrageServer,
+	admin admin.AdminServer, authService AuthService) *Server {
 	logrusLogger := logrus.NewEntry(logger)
 	server := &Server{
 		Logger:       logger,
@@ -47,6 +57,10 @@ func NewServer(logger *logrus.Logger, auth auth.AuthServer,
 		converter:    converter,
 		currencies:   currencies,
 		exchangePlot: exchangePlot,
+		alerts:       alerts,
+		converterExt: converterExt,
+		arbitrage:    arbitrage,
+		admin:        admin,
 		authService:  authService,
 	}
 
@@ -54,6 +68,7 @@ func NewServer(logger *logrus.Logger, auth auth.AuthServer,
 		grpc.StreamInterceptor(
 			grpc_middleware.ChainStreamServer(
 				grpc_logrus.StreamServerInterceptor(logrusLogger),
+				server.authStreamInterceptor,
 				grpc_recovery.StreamServerInterceptor(),
 			)),
 		grpc.UnaryInterceptor(
@@ -72,6 +87,25 @@ func (s *Server) authInterceptor(ctx context.Context,
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler) (interface{}, error) {
 	// Logic before invoking the invoker
+	h, err := handler(s.authContext(ctx), req)
+
+	return h, err
+}
+
+// authStreamInterceptor resolves the user of streams the same way as authInterceptor does for
+// unary calls
+func (s *Server) authStreamInterceptor(srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = s.authContext(stream.Context())
+	return handler(srv, wrapped)
+}
+
+// authContext adds id of the user of chat_id metadata to ctx, ctx is returned as is when the user
+// is not found
+func (s *Server) authContext(ctx context.Context) context.Context {
 	chatIdStr := metadata.ValueFromIncomingContext(ctx, chatIdKey)
 	if chatIdStr != nil && len(chatIdStr) > 0 {
 		chatId, err := strconv.Atoi(chatIdStr[0])
@@ -82,9 +116,7 @@ func (s *Server) authInterceptor(ctx context.Context,
 			}
 		}
 	}
-	h, err := handler(ctx, req)
-
-	return h, err
+	return ctx
 }
 
 func (s *Server) ListenAndServe(port int) error {
@@ -98,6 +130,10 @@ func (s *Server) ListenAndServe(port int) error {
 	converter.RegisterConverterServer(s.srv, s.converter)
 	currencies.RegisterCurrenciesServer(s.srv, s.currencies)
 	exchange_plot.RegisterExchangePlotServer(s.srv, s.exchangePlot)
+	alerts.RegisterAlertsServer(s.srv, s.alerts)
+	converter_ext.RegisterConverterExtServer(s.srv, s.converterExt)
+	arbitrage.RegisterArbitrageServer(s.srv, s.arbitrage)
+	admin.RegisterAdminServer(s.srv, s.admin)
 
 	if err := s.srv.Serve(lis); err != nil {
 		return err
@@ -2,23 +2,193 @@ package binance_api
 
 import (
 	"github.com/binance-converter/backend/core"
+	"github.com/binance-converter/backend/pkg/utils"
 	binanceP2PApi "github.com/binance-converter/binance-p2p-api"
+	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"net/http"
+)
+
+const (
+	defaultTransAmount = float64(10000)
+	maxOffersPerPage   = 20
+	merchantUserType   = "merchant"
+	// depthPrecision is a number of decimal places of volume weighted exchange and slippage
+	depthPrecision = 8
 )
 
 type BinanceApi struct {
-	api binanceP2PApi.BinanceP2PApi
+	url        string
+	client     *http.Client
+	limiter    *utils.RateLimiter
+	backoff    utils.Backoff
+	depthPages int
 }
 
-func NewBinanceApi() *BinanceApi {
-	return &BinanceApi{}
+func NewBinanceApi(cfg Config) (*BinanceApi, error) {
+	cfg = cfg.withDefaults()
+	limiter, err := utils.NewRateLimiter(cfg.RequestsPerSecond, cfg.Burst)
+	if err != nil {
+		return nil, err
+	}
+	return &BinanceApi{
+		url:     searchAdvUrl,
+		client:  &http.Client{Timeout: cfg.Timeout},
+		limiter: limiter,
+		backoff: utils.Backoff{
+			Attempts:  cfg.Attempts,
+			BaseDelay: cfg.BaseDelay,
+			MaxDelay:  cfg.MaxDelay,
+		},
+		depthPages: cfg.DepthPages,
+	}, nil
+}
+
+type exchangeRequest struct {
+	assets      string
+	fiat        string
+	payTypes    []string
+	tradeType   string
+	transAmount float64
+	// filter is applied by binance for merchants and by the client for other criteria
+	filter core.AdvertiserFilter
 }
 
 func (b *BinanceApi) GetExchange(ctx context.Context,
 	converterPair core.ConverterPair) (core.Exchange, error) {
+	request, err := b.makeExchangeRequest(ctx, converterPair)
+	if err != nil {
+		return core.Exchange{}, err
+	}
+
+	return b.getExchange(ctx, request)
+}
+
+// GetOffers returns up to limit best offers of binance p2p for converter pair
+func (b *BinanceApi) GetOffers(ctx context.Context, converterPair core.ConverterPair,
+	limit int) ([]core.Offer, error) {
+	if limit <= 0 || limit > maxOffersPerPage {
+		return nil, core.ErrorBinanceApiInvalidLimit
+	}
+
+	request, err := b.makeExchangeRequest(ctx, converterPair)
+	if err != nil {
+		return nil, err
+	}
+
+	advs, err := b.searchMatchingAdvs(ctx, request, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	offers := make([]core.Offer, 0, len(advs))
+	for _, data := range advs {
+		offer, err := convertBinanceDataToOffer(data)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"advNo": data.Adv.AdvNo,
+				"err":   err,
+			}).Error("Error parse offer")
+			return nil, err
+		}
+		offers = append(offers, offer)
+	}
+
+	return offers, nil
+}
+
+// GetDepthQuote fills amount of the converter pair with offers from several pages of binance p2p
+// order book and returns volume weighted exchange of the consumed offers
+func (b *BinanceApi) GetDepthQuote(ctx context.Context,
+	converterPair core.ConverterPair) (core.DepthQuote, error) {
+	if !converterPair.Amount.IsPositive() {
+		return core.DepthQuote{}, core.ErrorDepthInvalidAmount
+	}
+
+	// offers are not filtered by amount, because the amount is split between several of them
+	pricePair := converterPair
+	pricePair.Amount = decimal.Zero
+	request, err := b.makeExchangeRequest(ctx, pricePair)
+	if err != nil {
+		return core.DepthQuote{}, err
+	}
+	request.transAmount = 0
+
+	sellingCrypto := isSellingCrypto(converterPair)
+	quote := core.DepthQuote{
+		ConverterPair: converterPair,
+		AmountIn:      converterPair.Amount,
+	}
+	remaining := quote.AmountIn
+	for page := 1; page <= b.depthPages && remaining.IsPositive(); page++ {
+		response, err := b.searchAdvs(ctx, request, page, maxOffersPerPage)
+		if err != nil {
+			return core.DepthQuote{}, err
+		}
+
+		for _, data := range response.Data {
+			offer, err := convertBinanceDataToOffer(data)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"advNo": data.Adv.AdvNo,
+					"err":   err,
+				}).Error("Error parse offer")
+				return core.DepthQuote{}, core.ErrorBinanceApiBadResponse
+			}
+			if !request.filter.Match(offer.Advertiser) {
+				continue
+			}
+			if quote.BestExchange.IsZero() {
+				quote.BestExchange = offer.Exchange
+			}
+
+			fill, ok := fillOffer(offer, remaining, sellingCrypto)
+			if !ok {
+				continue
+			}
+			quote.Fills = append(quote.Fills, fill)
+			quote.AmountOut = quote.AmountOut.Add(fill.AmountOut)
+			remaining = remaining.Sub(fill.AmountIn)
+			if !remaining.IsPositive() {
+				break
+			}
+		}
+
+		if len(response.Data) < maxOffersPerPage {
+			break
+		}
+	}
+
+	if quote.BestExchange.IsZero() {
+		return core.DepthQuote{}, core.ErrorBinanceApiNoOffers
+	}
+	if remaining.IsPositive() {
+		logrus.WithFields(logrus.Fields{
+			"converterPair": converterPair,
+			"remaining":     remaining,
+			"fills":         len(quote.Fills),
+		}).Error("Not enough offers to fill amount")
+		return core.DepthQuote{}, core.ErrorDepthInsufficientLiquidity
+	}
+
+	if sellingCrypto {
+		quote.Exchange = quote.AmountOut.Div(quote.AmountIn).Round(depthPrecision)
+		quote.SlippagePercent = quote.BestExchange.Sub(quote.Exchange)
+	} else {
+		quote.Exchange = quote.AmountIn.Div(quote.AmountOut).Round(depthPrecision)
+		quote.SlippagePercent = quote.Exchange.Sub(quote.BestExchange)
+	}
+	quote.SlippagePercent = quote.SlippagePercent.Mul(decimal.NewFromInt(100)).
+		Div(quote.BestExchange).Round(depthPrecision)
+
+	return quote, nil
+}
+
+func (b *BinanceApi) makeExchangeRequest(ctx context.Context,
+	converterPair core.ConverterPair) (exchangeRequest, error) {
 	if len(converterPair.Currencies) != 2 {
-		return 0, core.ErrorBinanceApiInvalidConverterPair
+		return exchangeRequest{}, core.ErrorBinanceApiInvalidConverterPair
 	}
 
 	if converterPair.Currencies[0].CurrencyType == converterPair.Currencies[1].CurrencyType {
@@ -26,37 +196,222 @@ func (b *BinanceApi) GetExchange(ctx context.Context,
 			"currency1": converterPair.Currencies[0],
 			"currency2": converterPair.Currencies[1],
 		}).Error("Currencies is equal")
-		return 0, core.ErrorBinanceApiInvalidConverterPair
+		return exchangeRequest{}, core.ErrorBinanceApiInvalidConverterPair
 	}
 
-	var assets, fiat, tradeType string
-	transAmount := float64(10000)
-	var payTypes []string
+	var request exchangeRequest
 
 	if converterPair.Currencies[0].CurrencyType == core.CurrencyTypeCrypto {
-		assets = string(converterPair.Currencies[0].CurrencyCode)
-		payTypes = []string{string(converterPair.Currencies[1].BankCode)}
-		fiat = string(converterPair.Currencies[1].CurrencyCode)
-		tradeType = binanceP2PApi.OperationSell
+		request.assets = string(converterPair.Currencies[0].CurrencyCode)
+		request.payTypes = []string{string(converterPair.Currencies[1].BankCode)}
+		request.fiat = string(converterPair.Currencies[1].CurrencyCode)
+		request.tradeType = binanceP2PApi.OperationSell
 	} else {
-		assets = string(converterPair.Currencies[1].CurrencyCode)
-		payTypes = []string{string(converterPair.Currencies[0].BankCode)}
-		fiat = string(converterPair.Currencies[0].CurrencyCode)
-		tradeType = binanceP2PApi.OperationBuy
+		request.assets = string(converterPair.Currencies[1].CurrencyCode)
+		request.payTypes = []string{string(converterPair.Currencies[0].BankCode)}
+		request.fiat = string(converterPair.Currencies[0].CurrencyCode)
+		request.tradeType = binanceP2PApi.OperationBuy
+	}
+
+	switch converterPair.TradeSide {
+	case core.TradeSideAuto:
+	case core.TradeSideBuy:
+		request.tradeType = binanceP2PApi.OperationBuy
+	case core.TradeSideSell:
+		request.tradeType = binanceP2PApi.OperationSell
+	default:
+		return exchangeRequest{}, core.ErrorBinanceApiInvalidTradeSide
+	}
+
+	if converterPair.Amount.IsNegative() {
+		return exchangeRequest{}, core.ErrorBinanceApiInvalidAmount
+	}
+
+	if converterPair.AdvertiserFilter != nil {
+		request.filter = *converterPair.AdvertiserFilter
 	}
 
-	exchange, _, _, err := b.api.GetExchange(assets, fiat, payTypes, tradeType,
-		transAmount)
+	// binance p2p filters offers by fiat amount
+	request.transAmount = converterPair.Amount.InexactFloat64()
+	if converterPair.Amount.IsZero() {
+		request.transAmount = defaultTransAmount
+	} else if converterPair.Currencies[0].CurrencyType == core.CurrencyTypeCrypto {
+		priceRequest := request
+		priceRequest.transAmount = 0
+		price, err := b.getExchange(ctx, priceRequest)
+		if err != nil {
+			return exchangeRequest{}, err
+		}
+		request.transAmount = converterPair.Amount.Mul(price).InexactFloat64()
+	}
+
+	return request, nil
+}
+
+func (b *BinanceApi) getExchange(ctx context.Context, request exchangeRequest) (core.Exchange,
+	error) {
+	advs, err := b.searchMatchingAdvs(ctx, request, 1)
 	if err != nil {
+		return core.Exchange{}, err
+	}
+
+	if len(advs) == 0 {
 		logrus.WithFields(logrus.Fields{
-			"assets":      assets,
-			"fiat":        fiat,
-			"payTypes":    payTypes,
-			"tradeType":   tradeType,
-			"transAmount": transAmount,
-			"err":         err,
-		}).Error("Error get exchange")
+			"assets":      request.assets,
+			"fiat":        request.fiat,
+			"payTypes":    request.payTypes,
+			"tradeType":   request.tradeType,
+			"transAmount": request.transAmount,
+			"filter":      request.filter,
+		}).Error("No offers for exchange")
+		return core.Exchange{}, core.ErrorBinanceApiNoOffers
 	}
 
-	return core.Exchange(exchange), err
+	exchange, err := decimal.NewFromString(advs[0].Adv.Price)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"price": advs[0].Adv.Price,
+			"err":   err,
+		}).Error("Error parse exchange")
+		return core.Exchange{}, core.ErrorBinanceApiBadResponse
+	}
+
+	return exchange, nil
+}
+
+// searchMatchingAdvs returns up to limit best advertisements of advertisers selected by the filter
+// of the request, binance can not apply filters other than merchant one, so pages of offers are
+// fetched until limit is reached
+func (b *BinanceApi) searchMatchingAdvs(ctx context.Context, request exchangeRequest,
+	limit int) ([]binanceP2PApi.Data, error) {
+	if !needsAdvertiserCheck(request.filter) {
+		response, err := b.searchAdvs(ctx, request, 1, limit)
+		if err != nil {
+			return nil, err
+		}
+		return response.Data, nil
+	}
+
+	advs := make([]binanceP2PApi.Data, 0, limit)
+	for page := 1; page <= b.depthPages; page++ {
+		response, err := b.searchAdvs(ctx, request, page, maxOffersPerPage)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, data := range response.Data {
+			if !request.filter.Match(convertBinanceAdvertiser(data.Advertiser)) {
+				continue
+			}
+			advs = append(advs, data)
+			if len(advs) == limit {
+				return advs, nil
+			}
+		}
+
+		if len(response.Data) < maxOffersPerPage {
+			break
+		}
+	}
+	return advs, nil
+}
+
+// needsAdvertiserCheck reports whether the filter has criteria not applied by binance
+func needsAdvertiserCheck(filter core.AdvertiserFilter) bool {
+	return filter.MinCompletionRate > 0 || filter.MinOrderCount > 0 ||
+		len(filter.ExcludedAdvertisers) > 0
+}
+
+// isSellingCrypto reports whether crypto currency is sold, forced trade side is used when it is set
+// and the side is inferred from order of currencies otherwise
+func isSellingCrypto(converterPair core.ConverterPair) bool {
+	switch converterPair.TradeSide {
+	case core.TradeSideBuy:
+		return false
+	case core.TradeSideSell:
+		return true
+	}
+	return converterPair.Currencies[0].CurrencyType == core.CurrencyTypeCrypto
+}
+
+// fillOffer returns part of amount of the first currency of the pair taken by the offer with amount
+// of the second currency received for it, limits of the offer are in classic currency and its
+// available quantity is in crypto currency
+func fillOffer(offer core.Offer, amount decimal.Decimal, sellingCrypto bool) (core.DepthFill,
+	bool) {
+	price := offer.Exchange
+	if !price.IsPositive() {
+		return core.DepthFill{}, false
+	}
+
+	maxClassic := offer.AvailableQuantity.Mul(price)
+	if limit := offer.MaxAmount; limit.IsPositive() && limit.LessThan(maxClassic) {
+		maxClassic = limit
+	}
+
+	fill := core.DepthFill{Offer: offer, AmountIn: amount}
+	classic := amount
+	if sellingCrypto {
+		classic = amount.Mul(price)
+	}
+	if classic.GreaterThan(maxClassic) {
+		classic = maxClassic
+		fill.AmountIn = classic
+		if sellingCrypto {
+			fill.AmountIn = classic.Div(price)
+		}
+	}
+	if !classic.IsPositive() || classic.LessThan(offer.MinAmount) {
+		return core.DepthFill{}, false
+	}
+
+	fill.AmountOut = classic.Div(price)
+	if sellingCrypto {
+		fill.AmountOut = classic
+	}
+	return fill, true
+}
+
+func convertBinanceDataToOffer(data binanceP2PApi.Data) (core.Offer, error) {
+	price, err := decimal.NewFromString(data.Adv.Price)
+	if err != nil {
+		return core.Offer{}, err
+	}
+	minAmount, err := decimal.NewFromString(data.Adv.MinSingleTransAmount)
+	if err != nil {
+		return core.Offer{}, err
+	}
+	maxAmount, err := decimal.NewFromString(data.Adv.MaxSingleTransAmount)
+	if err != nil {
+		return core.Offer{}, err
+	}
+	availableQuantity, err := decimal.NewFromString(data.Adv.SurplusAmount)
+	if err != nil {
+		return core.Offer{}, err
+	}
+
+	payMethods := make([]string, 0, len(data.Adv.TradeMethods))
+	for _, method := range data.Adv.TradeMethods {
+		payMethods = append(payMethods, method.Identifier)
+	}
+
+	return core.Offer{
+		AdvNo:             data.Adv.AdvNo,
+		Exchange:          price,
+		MinAmount:         minAmount,
+		MaxAmount:         maxAmount,
+		AvailableQuantity: availableQuantity,
+		PayMethods:        payMethods,
+		Advertiser:        convertBinanceAdvertiser(data.Advertiser),
+	}, nil
+}
+
+func convertBinanceAdvertiser(advertiser binanceP2PApi.Advertiser) core.Advertiser {
+	return core.Advertiser{
+		UserNo:          advertiser.UserNo,
+		NickName:        advertiser.NickName,
+		IsMerchant:      advertiser.UserType == merchantUserType,
+		MonthOrderCount: advertiser.MonthOrderCount,
+		CompletionRate:  advertiser.MonthFinishRate,
+	}
 }
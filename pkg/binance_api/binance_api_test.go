@@ -0,0 +1,81 @@
+package binance_api
+
+import (
+	"encoding/json"
+	"github.com/binance-converter/backend/core"
+	binanceP2PApi "github.com/binance-converter/binance-p2p-api"
+	"github.com/shopspring/decimal"
+	"golang.org/x/net/context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetDepthQuoteTradeSide(t *testing.T) {
+	rubTinkoff := core.FullCurrency{
+		CurrencyType: core.CurrencyTypeClassic,
+		CurrencyCode: "RUB",
+		BankCode:     "TinkoffNew",
+	}
+	usdt := core.FullCurrency{
+		CurrencyType: core.CurrencyTypeCrypto,
+		CurrencyCode: "USDT",
+	}
+
+	tests := []struct {
+		name          string
+		side          core.TradeSide
+		wantTradeType string
+		wantAmountOut string
+	}{
+		// 10 RUB buy 0.1 USDT at 100 RUB per USDT
+		{"inferred side", core.TradeSideAuto, binanceP2PApi.OperationBuy, "0.1"},
+		{"forced buy", core.TradeSideBuy, binanceP2PApi.OperationBuy, "0.1"},
+		// forced sell spends the amount as crypto currency, 10 USDT are sold for 1000 RUB
+		{"forced sell", core.TradeSideSell, binanceP2PApi.OperationSell, "1000"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var request binanceP2PApi.Request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter,
+				r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Errorf("decode request: %v", err)
+				}
+				_ = json.NewEncoder(w).Encode(binanceP2PApi.Response{
+					Success: true,
+					Data: []binanceP2PApi.Data{{Adv: binanceP2PApi.Adv{
+						AdvNo:                "1",
+						Price:                "100",
+						SurplusAmount:        "1000",
+						MinSingleTransAmount: "1",
+						MaxSingleTransAmount: "100000",
+					}}},
+				})
+			}))
+			defer server.Close()
+
+			api, err := NewBinanceApi(Config{Attempts: 1})
+			if err != nil {
+				t.Fatalf("new binance api: %v", err)
+			}
+			api.url = server.URL
+
+			quote, err := api.GetDepthQuote(context.Background(), core.ConverterPair{
+				Currencies: []core.FullCurrency{rubTinkoff, usdt},
+				Amount:     decimal.NewFromInt(10),
+				TradeSide:  test.side,
+			})
+			if err != nil {
+				t.Fatalf("get depth quote: %v", err)
+			}
+			if request.TradeType != test.wantTradeType {
+				t.Fatalf("trade type = %s, want %s", request.TradeType, test.wantTradeType)
+			}
+			if !quote.AmountOut.Equal(decimal.RequireFromString(test.wantAmountOut)) {
+				t.Fatalf("amount out = %s, want %s", quote.AmountOut, test.wantAmountOut)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package binance_api
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/binance-converter/backend/core"
+	"github.com/binance-converter/backend/pkg/utils"
+	binanceP2PApi "github.com/binance-converter/binance-p2p-api"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"net/http"
+	"time"
+)
+
+const (
+	searchAdvUrl = "https://p2p.binance.com/bapi/c2c/v2/friendly/c2c/adv/search"
+
+	defaultRequestsPerSecond = 2
+	defaultBurst             = 5
+	defaultAttempts          = 3
+	defaultBaseDelay         = 500 * time.Millisecond
+	defaultMaxDelay          = 5 * time.Second
+	defaultTimeout           = 5 * time.Second
+	defaultDepthPages        = 5
+)
+
+// Config zero values are replaced with defaults
+type Config struct {
+	// RequestsPerSecond and Burst limit requests to binance shared by all callers
+	RequestsPerSecond float64
+	Burst             int
+	// Attempts is a maximum number of attempts of a request when binance is rate limited or
+	// unavailable, delay between attempts grows exponentially from BaseDelay to MaxDelay
+	Attempts  int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Timeout of a single http request
+	Timeout time.Duration
+	// DepthPages limits pages of offers fetched for a depth quote
+	DepthPages int
+}
+
+func (c Config) withDefaults() Config {
+	if c.RequestsPerSecond <= 0 {
+		c.RequestsPerSecond = defaultRequestsPerSecond
+	}
+	if c.Burst <= 0 {
+		c.Burst = defaultBurst
+	}
+	if c.Attempts <= 0 {
+		c.Attempts = defaultAttempts
+	}
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = defaultBaseDelay
+	}
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = defaultMaxDelay
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.DepthPages <= 0 {
+		c.DepthPages = defaultDepthPages
+	}
+	return c
+}
+
+// searchAdvs requests a page of binance p2p advertisements respecting the rate limit and retrying
+// on rate limit and availability errors
+func (b *BinanceApi) searchAdvs(ctx context.Context, request exchangeRequest, page,
+	rows int) (binanceP2PApi.Response, error) {
+	body := binanceP2PApi.Request{
+		Asset:       request.assets,
+		Fiat:        request.fiat,
+		Page:        page,
+		PayTypes:    request.payTypes,
+		Rows:        rows,
+		TradeType:   request.tradeType,
+		TransAmount: request.transAmount,
+	}
+	if request.filter.MerchantOnly {
+		publisherType := merchantUserType
+		body.PublisherType = &publisherType
+	}
+
+	var response binanceP2PApi.Response
+	err := utils.DoWithBackoff(ctx, func(ctx context.Context) error {
+		if err := b.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		var err error
+		response, err = b.doSearchAdvs(ctx, body)
+		return err
+	}, b.backoff, isRetryableError)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"request": body,
+			"err":     err,
+		}).Error("Error search advertisements")
+	}
+
+	return response, err
+}
+
+func (b *BinanceApi) doSearchAdvs(ctx context.Context,
+	body binanceP2PApi.Request) (binanceP2PApi.Response, error) {
+	bodyJson, err := json.Marshal(body)
+	if err != nil {
+		return binanceP2PApi.Response{}, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, b.url,
+		bytes.NewReader(bodyJson))
+	if err != nil {
+		return binanceP2PApi.Response{}, err
+	}
+	request.Header.Set(binanceP2PApi.HeaderContentType, binanceP2PApi.ApplicationJsonContentType)
+	request.Header.Set(binanceP2PApi.HeaderOrigin, binanceP2PApi.P2PBinanceOrigin)
+	request.Header.Set(binanceP2PApi.HeaderPragma, binanceP2PApi.NoCashPragma)
+	request.Header.Set(binanceP2PApi.HeaderTE, binanceP2PApi.TrailersTE)
+	request.Header.Set(binanceP2PApi.HeaderUserAgent, binanceP2PApi.MozillaUserAgent)
+
+	responseRaw, err := b.client.Do(request)
+	if err != nil {
+		if ctx.Err() != nil {
+			return binanceP2PApi.Response{}, ctx.Err()
+		}
+		logrus.WithFields(logrus.Fields{
+			"err": err,
+		}).Warn("Error send request to binance")
+		return binanceP2PApi.Response{}, core.ErrorBinanceApiUnavailable
+	}
+	defer responseRaw.Body.Close()
+
+	switch {
+	case responseRaw.StatusCode == http.StatusTooManyRequests ||
+		responseRaw.StatusCode == http.StatusTeapot:
+		return binanceP2PApi.Response{}, core.ErrorBinanceApiRateLimited
+	case responseRaw.StatusCode >= http.StatusInternalServerError:
+		return binanceP2PApi.Response{}, core.ErrorBinanceApiUnavailable
+	case responseRaw.StatusCode != http.StatusOK:
+		logrus.WithFields(logrus.Fields{
+			"status": responseRaw.Status,
+		}).Error("Unexpected binance response status")
+		return binanceP2PApi.Response{}, core.ErrorBinanceApiBadResponse
+	}
+
+	var response binanceP2PApi.Response
+	if err := json.NewDecoder(responseRaw.Body).Decode(&response); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Error decode binance response")
+		return binanceP2PApi.Response{}, core.ErrorBinanceApiBadResponse
+	}
+	if !response.Success {
+		logrus.WithFields(logrus.Fields{
+			"code":    response.Code,
+			"message": response.Message,
+		}).Warn("Binance response is not successful")
+		return binanceP2PApi.Response{}, core.ErrorBinanceApiUnavailable
+	}
+
+	return response, nil
+}
+
+func isRetryableError(err error) bool {
+	return err == core.ErrorBinanceApiRateLimited || err == core.ErrorBinanceApiUnavailable
+}
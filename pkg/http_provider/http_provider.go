@@ -0,0 +1,271 @@
+package http_provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	exchangePath = "/exchange"
+	offersPath   = "/offers"
+
+	defaultTimeout = 5 * time.Second
+
+	currencyTypeCrypto  = "crypto"
+	currencyTypeClassic = "classic"
+
+	tradeSideAuto = "auto"
+	tradeSideBuy  = "buy"
+	tradeSideSell = "sell"
+)
+
+// Config zero values are replaced with defaults
+type Config struct {
+	// Url is a base url of the provider, /exchange and /offers endpoints are requested relative
+	// to it
+	Url     string
+	Timeout time.Duration
+}
+
+// HttpProvider quotes converter pairs from an http endpoint which speaks simple json protocol:
+//
+//	POST /exchange {"currencies": [...], "amount": 0, "side": "auto"} -> {"exchange": 95.5}
+//	POST /offers {"currencies": [...], "amount": 0, "side": "auto", "limit": 5}
+//		-> {"offers": [...]}
+type HttpProvider struct {
+	url    string
+	client *http.Client
+}
+
+func NewHttpProvider(cfg Config) *HttpProvider {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	return &HttpProvider{
+		url:    strings.TrimSuffix(cfg.Url, "/"),
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+type currency struct {
+	Type string `json:"type"`
+	Code string `json:"code"`
+	Bank string `json:"bank,omitempty"`
+}
+
+type advertiserFilter struct {
+	MerchantOnly        bool     `json:"merchantOnly,omitempty"`
+	MinCompletionRate   float64  `json:"minCompletionRate,omitempty"`
+	MinOrderCount       int      `json:"minOrderCount,omitempty"`
+	ExcludedAdvertisers []string `json:"excludedAdvertisers,omitempty"`
+}
+
+type quoteRequest struct {
+	Currencies []currency `json:"currencies"`
+	// Amount is encoded as exact json number
+	Amount json.Number `json:"amount"`
+	Side   string      `json:"side"`
+	Limit  int         `json:"limit,omitempty"`
+	// Filter selects advertisers the exchange is taken from, provider quotes all offers without it
+	Filter *advertiserFilter `json:"filter,omitempty"`
+}
+
+type exchangeResponse struct {
+	// Exchange is decoded exactly both from json numbers and strings
+	Exchange decimal.Decimal `json:"exchange"`
+}
+
+type offer struct {
+	AdvNo             string          `json:"advNo"`
+	UserNo            string          `json:"userNo"`
+	NickName          string          `json:"nickName"`
+	Merchant          bool            `json:"merchant"`
+	MonthOrderCount   int             `json:"monthOrderCount"`
+	CompletionRate    float64         `json:"completionRate"`
+	Exchange          decimal.Decimal `json:"exchange"`
+	MinAmount         decimal.Decimal `json:"minAmount"`
+	MaxAmount         decimal.Decimal `json:"maxAmount"`
+	AvailableQuantity decimal.Decimal `json:"availableQuantity"`
+	PayMethods        []string        `json:"payMethods"`
+}
+
+type offersResponse struct {
+	Offers []offer `json:"offers"`
+}
+
+func (h *HttpProvider) GetExchange(ctx context.Context,
+	converterPair core.ConverterPair) (core.Exchange, error) {
+	request, err := makeQuoteRequest(converterPair)
+	if err != nil {
+		return core.Exchange{}, err
+	}
+
+	var response exchangeResponse
+	if err := h.post(ctx, exchangePath, request, &response); err != nil {
+		return core.Exchange{}, err
+	}
+	if response.Exchange.Sign() <= 0 {
+		logrus.WithFields(logrus.Fields{
+			"request":  request,
+			"exchange": response.Exchange,
+		}).Error("Invalid exchange of http provider")
+		return core.Exchange{}, core.ErrorProviderBadResponse
+	}
+
+	return response.Exchange, nil
+}
+
+func (h *HttpProvider) GetOffers(ctx context.Context, converterPair core.ConverterPair,
+	limit int) ([]core.Offer, error) {
+	if limit <= 0 {
+		return nil, core.ErrorOfferInvalidLimit
+	}
+
+	request, err := makeQuoteRequest(converterPair)
+	if err != nil {
+		return nil, err
+	}
+	request.Limit = limit
+
+	var response offersResponse
+	if err := h.post(ctx, offersPath, request, &response); err != nil {
+		return nil, err
+	}
+
+	offers := make([]core.Offer, 0, len(response.Offers))
+	for _, offer := range response.Offers {
+		advertiser := core.Advertiser{
+			UserNo:          offer.UserNo,
+			NickName:        offer.NickName,
+			IsMerchant:      offer.Merchant,
+			MonthOrderCount: offer.MonthOrderCount,
+			CompletionRate:  offer.CompletionRate,
+		}
+		// provider may not support filters, so offers are checked once more
+		if converterPair.AdvertiserFilter != nil &&
+			!converterPair.AdvertiserFilter.Match(advertiser) {
+			continue
+		}
+		offers = append(offers, core.Offer{
+			AdvNo:             offer.AdvNo,
+			Advertiser:        advertiser,
+			Exchange:          offer.Exchange,
+			MinAmount:         offer.MinAmount,
+			MaxAmount:         offer.MaxAmount,
+			AvailableQuantity: offer.AvailableQuantity,
+			PayMethods:        offer.PayMethods,
+		})
+	}
+	if len(offers) > limit {
+		offers = offers[:limit]
+	}
+
+	return offers, nil
+}
+
+func (h *HttpProvider) post(ctx context.Context, path string, body interface{},
+	response interface{}) error {
+	bodyJson, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url+path,
+		bytes.NewReader(bodyJson))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	responseRaw, err := h.client.Do(request)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		logrus.WithFields(logrus.Fields{
+			"url": h.url + path,
+			"err": err,
+		}).Warn("Error send request to http provider")
+		return core.ErrorProviderUnavailable
+	}
+	defer responseRaw.Body.Close()
+
+	switch {
+	case responseRaw.StatusCode == http.StatusNotFound:
+		return core.ErrorProviderNoOffers
+	case responseRaw.StatusCode == http.StatusTooManyRequests ||
+		responseRaw.StatusCode >= http.StatusInternalServerError:
+		return core.ErrorProviderUnavailable
+	case responseRaw.StatusCode != http.StatusOK:
+		logrus.WithFields(logrus.Fields{
+			"url":    h.url + path,
+			"status": responseRaw.Status,
+		}).Error("Unexpected http provider response status")
+		return core.ErrorProviderBadResponse
+	}
+
+	if err := json.NewDecoder(responseRaw.Body).Decode(response); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"url": h.url + path,
+			"err": err,
+		}).Error("Error decode http provider response")
+		return core.ErrorProviderBadResponse
+	}
+
+	return nil
+}
+
+func makeQuoteRequest(converterPair core.ConverterPair) (quoteRequest, error) {
+	if len(converterPair.Currencies) != 2 {
+		return quoteRequest{}, core.ErrorConverterInvalidConverterPair
+	}
+	if converterPair.Amount.IsNegative() {
+		return quoteRequest{}, core.ErrorConverterInvalidAmount
+	}
+
+	request := quoteRequest{
+		Currencies: make([]currency, 0, len(converterPair.Currencies)),
+		Amount:     json.Number(converterPair.Amount.String()),
+	}
+
+	for _, fullCurrency := range converterPair.Currencies {
+		currencyType := currencyTypeCrypto
+		if fullCurrency.CurrencyType == core.CurrencyTypeClassic {
+			currencyType = currencyTypeClassic
+		}
+		request.Currencies = append(request.Currencies, currency{
+			Type: currencyType,
+			Code: string(fullCurrency.CurrencyCode),
+			Bank: string(fullCurrency.BankCode),
+		})
+	}
+
+	switch converterPair.TradeSide {
+	case core.TradeSideAuto:
+		request.Side = tradeSideAuto
+	case core.TradeSideBuy:
+		request.Side = tradeSideBuy
+	case core.TradeSideSell:
+		request.Side = tradeSideSell
+	default:
+		return quoteRequest{}, core.ErrorConverterInvalidTradeSide
+	}
+
+	if filter := converterPair.AdvertiserFilter; filter != nil && !filter.IsEmpty() {
+		request.Filter = &advertiserFilter{
+			MerchantOnly:        filter.MerchantOnly,
+			MinCompletionRate:   filter.MinCompletionRate,
+			MinOrderCount:       filter.MinOrderCount,
+			ExcludedAdvertisers: filter.ExcludedAdvertisers,
+		}
+	}
+
+	return request, nil
+}
@@ -0,0 +1,160 @@
+package http_provider
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/binance-converter/backend/core"
+	"github.com/shopspring/decimal"
+	"golang.org/x/net/context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testConverterPair = core.ConverterPair{
+	Currencies: []core.FullCurrency{
+		{
+			CurrencyType: core.CurrencyTypeClassic,
+			CurrencyCode: "RUB",
+			BankCode:     "TinkoffNew",
+		},
+		{
+			CurrencyType: core.CurrencyTypeCrypto,
+			CurrencyCode: "USDT",
+		},
+	},
+	Amount: decimal.RequireFromString("1000.10"),
+}
+
+// newTestProvider starts a stand-in server answering every request with handler
+func newTestProvider(t *testing.T, timeout time.Duration,
+	handler http.HandlerFunc) *HttpProvider {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewHttpProvider(Config{Url: server.URL + "/", Timeout: timeout})
+}
+
+func TestHttpProviderGetExchange(t *testing.T) {
+	var request quoteRequest
+	provider := newTestProvider(t, 0, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != exchangePath {
+			t.Errorf("request = %s %s, want POST %s", r.Method, r.URL.Path, exchangePath)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"exchange": "95.123456789"}`))
+	})
+
+	exchange, err := provider.GetExchange(context.Background(), testConverterPair)
+	if err != nil {
+		t.Fatalf("get exchange: %v", err)
+	}
+	if !exchange.Equal(decimal.RequireFromString("95.123456789")) {
+		t.Fatalf("exchange = %s, want 95.123456789", exchange)
+	}
+
+	if request.Amount != "1000.1" || request.Side != tradeSideAuto {
+		t.Fatalf("request amount %s side %s, want 1000.1 %s", request.Amount, request.Side,
+			tradeSideAuto)
+	}
+	want := []currency{
+		{Type: currencyTypeClassic, Code: "RUB", Bank: "TinkoffNew"},
+		{Type: currencyTypeCrypto, Code: "USDT"},
+	}
+	if len(request.Currencies) != len(want) {
+		t.Fatalf("request currencies = %v, want %v", request.Currencies, want)
+	}
+	for i := range want {
+		if request.Currencies[i] != want[i] {
+			t.Fatalf("request currencies = %v, want %v", request.Currencies, want)
+		}
+	}
+}
+
+func TestHttpProviderErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{"not found", http.StatusNotFound, ``, core.ErrorProviderNoOffers},
+		{"too many requests", http.StatusTooManyRequests, ``, core.ErrorProviderUnavailable},
+		{"server error", http.StatusBadGateway, ``, core.ErrorProviderUnavailable},
+		{"bad request", http.StatusBadRequest, ``, core.ErrorProviderBadResponse},
+		{"bad json", http.StatusOK, `{"exchange": `, core.ErrorProviderBadResponse},
+		{"not a number", http.StatusOK, `{"exchange": "fast"}`, core.ErrorProviderBadResponse},
+		{"zero exchange", http.StatusOK, `{"exchange": 0}`, core.ErrorProviderBadResponse},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newTestProvider(t, 0, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			})
+
+			_, err := provider.GetExchange(context.Background(), testConverterPair)
+			if !errors.Is(err, test.want) {
+				t.Fatalf("get exchange: got error %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestHttpProviderTimeout(t *testing.T) {
+	// handler answers later than both the client timeout and the context deadline
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(500 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+	}
+
+	t.Run("client timeout", func(t *testing.T) {
+		provider := newTestProvider(t, 10*time.Millisecond, handler)
+
+		_, err := provider.GetExchange(context.Background(), testConverterPair)
+		if !errors.Is(err, core.ErrorProviderUnavailable) {
+			t.Fatalf("get exchange: got error %v, want %v", err, core.ErrorProviderUnavailable)
+		}
+	})
+
+	t.Run("context deadline", func(t *testing.T) {
+		provider := newTestProvider(t, time.Minute, handler)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := provider.GetExchange(ctx, testConverterPair)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("get exchange: got error %v, want %v", err, context.DeadlineExceeded)
+		}
+	})
+}
+
+func TestHttpProviderGetOffers(t *testing.T) {
+	provider := newTestProvider(t, 0, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != offersPath {
+			t.Errorf("path = %s, want %s", r.URL.Path, offersPath)
+		}
+		_, _ = w.Write([]byte(`{"offers": [
+			{"advNo": "1", "userNo": "a", "exchange": "95.5", "completionRate": 0.5},
+			{"advNo": "2", "userNo": "b", "exchange": 96, "completionRate": 0.99},
+			{"advNo": "3", "userNo": "c", "exchange": 97, "completionRate": 0.98}
+		]}`))
+	})
+
+	converterPair := testConverterPair
+	converterPair.AdvertiserFilter = &core.AdvertiserFilter{MinCompletionRate: 0.9}
+	offers, err := provider.GetOffers(context.Background(), converterPair, 1)
+	if err != nil {
+		t.Fatalf("get offers: %v", err)
+	}
+	if len(offers) != 1 || offers[0].AdvNo != "2" {
+		t.Fatalf("offers = %+v, want only the offer 2 matching the filter", offers)
+	}
+}
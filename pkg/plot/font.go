@@ -0,0 +1,57 @@
+package plot
+
+import (
+	"image"
+	"image/color"
+)
+
+const (
+	glyphWidth  = 3
+	glyphHeight = 5
+	glyphScale  = 2
+	glyphSpace  = 1
+)
+
+// glyphs is a minimal 3x5 bitmap font with symbols used in axis labels
+var glyphs = map[rune][glyphHeight]string{
+	'0': {"###", "#.#", "#.#", "#.#", "###"},
+	'1': {".#.", "##.", ".#.", ".#.", "###"},
+	'2': {"###", "..#", "###", "#..", "###"},
+	'3': {"###", "..#", "###", "..#", "###"},
+	'4': {"#.#", "#.#", "###", "..#", "..#"},
+	'5': {"###", "#..", "###", "..#", "###"},
+	'6': {"###", "#..", "###", "#.#", "###"},
+	'7': {"###", "..#", ".#.", ".#.", ".#."},
+	'8': {"###", "#.#", "###", "#.#", "###"},
+	'9': {"###", "#.#", "###", "..#", "###"},
+	'.': {"...", "...", "...", "...", ".#."},
+	':': {"...", ".#.", "...", ".#.", "..."},
+	'-': {"...", "...", "###", "...", "..."},
+	' ': {"...", "...", "...", "...", "..."},
+}
+
+func textWidth(text string) int {
+	return len([]rune(text)) * (glyphWidth + glyphSpace) * glyphScale
+}
+
+func textHeight() int {
+	return glyphHeight * glyphScale
+}
+
+// drawText draws text with top left corner in (x, y), unknown symbols are skipped
+func drawText(img *image.RGBA, x, y int, text string, c color.Color) {
+	for _, symbol := range text {
+		glyph, ok := glyphs[symbol]
+		if ok {
+			for row, line := range glyph {
+				for col, pixel := range line {
+					if pixel != '#' {
+						continue
+					}
+					fillRect(img, x+col*glyphScale, y+row*glyphScale, glyphScale, glyphScale, c)
+				}
+			}
+		}
+		x += (glyphWidth + glyphSpace) * glyphScale
+	}
+}
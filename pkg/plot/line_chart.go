@@ -0,0 +1,216 @@
+package plot
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"strconv"
+	"time"
+)
+
+const (
+	timeLabelLayout = "01.02 15:04"
+	yTicks          = 5
+	xTicks          = 4
+	tickLength      = 4
+	markerSize      = 6
+	padding         = 10
+)
+
+var (
+	backgroundColor = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	axisColor       = color.RGBA{R: 0, G: 0, B: 0, A: 255}
+	gridColor       = color.RGBA{R: 225, G: 225, B: 225, A: 255}
+	lineColor       = color.RGBA{R: 30, G: 90, B: 200, A: 255}
+	minColor        = color.RGBA{R: 210, G: 40, B: 40, A: 255}
+	maxColor        = color.RGBA{R: 30, G: 150, B: 60, A: 255}
+)
+
+var (
+	ErrorNoPoints      = errors.New("no points for plot")
+	ErrorInvalidSize   = errors.New("invalid plot size")
+	ErrorInvalidPoints = errors.New("invalid plot points")
+)
+
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// LineChart renders points sorted by time into a line chart with axes, time labels and min/max
+// markers
+func LineChart(points []Point, width, height int) (*image.RGBA, error) {
+	if len(points) == 0 {
+		return nil, ErrorNoPoints
+	}
+
+	minPoint, maxPoint := points[0], points[0]
+	for i, point := range points {
+		if math.IsNaN(point.Value) || math.IsInf(point.Value, 0) {
+			return nil, ErrorInvalidPoints
+		}
+		if i > 0 && point.Time.Before(points[i-1].Time) {
+			return nil, ErrorInvalidPoints
+		}
+		if point.Value < minPoint.Value {
+			minPoint = point
+		}
+		if point.Value > maxPoint.Value {
+			maxPoint = point
+		}
+	}
+
+	minValue, maxValue := minPoint.Value, maxPoint.Value
+	if minValue == maxValue {
+		delta := math.Abs(minValue) * 0.01
+		if delta == 0 {
+			delta = 1
+		}
+		minValue, maxValue = minValue-delta, maxValue+delta
+	}
+	valuePadding := (maxValue - minValue) * 0.05
+	minValue, maxValue = minValue-valuePadding, maxValue+valuePadding
+
+	startTime, endTime := points[0].Time, points[len(points)-1].Time
+	if !endTime.After(startTime) {
+		startTime, endTime = startTime.Add(-time.Minute), endTime.Add(time.Minute)
+	}
+
+	precision := valuePrecision(maxValue - minValue)
+	labelWidth := 0
+	for i := 0; i <= yTicks; i++ {
+		value := minValue + (maxValue-minValue)*float64(i)/yTicks
+		if w := textWidth(formatValue(value, precision)); w > labelWidth {
+			labelWidth = w
+		}
+	}
+
+	left := padding + labelWidth + tickLength + padding
+	right := width - padding - textWidth(timeLabelLayout)/2
+	top := padding + textHeight()
+	bottom := height - padding - textHeight() - tickLength - padding
+	if right-left < 2*markerSize || bottom-top < 2*markerSize {
+		return nil, ErrorInvalidSize
+	}
+
+	x := func(t time.Time) int {
+		return left + int(float64(right-left)*float64(t.Sub(startTime))/
+			float64(endTime.Sub(startTime)))
+	}
+	y := func(value float64) int {
+		return bottom - int(float64(bottom-top)*(value-minValue)/(maxValue-minValue))
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: backgroundColor}, image.Point{}, draw.Src)
+
+	for i := 0; i <= yTicks; i++ {
+		value := minValue + (maxValue-minValue)*float64(i)/yTicks
+		tickY := y(value)
+		drawLine(img, left, tickY, right, tickY, gridColor)
+		drawLine(img, left-tickLength, tickY, left, tickY, axisColor)
+		label := formatValue(value, precision)
+		drawText(img, left-tickLength-padding/2-textWidth(label), tickY-textHeight()/2, label,
+			axisColor)
+	}
+
+	for i := 0; i <= xTicks; i++ {
+		t := startTime.Add(time.Duration(float64(endTime.Sub(startTime)) * float64(i) / xTicks))
+		tickX := x(t)
+		drawLine(img, tickX, top, tickX, bottom, gridColor)
+		drawLine(img, tickX, bottom, tickX, bottom+tickLength, axisColor)
+		label := t.Format(timeLabelLayout)
+		drawText(img, tickX-textWidth(label)/2, bottom+tickLength+padding/2, label, axisColor)
+	}
+
+	drawLine(img, left, top, left, bottom, axisColor)
+	drawLine(img, left, bottom, right, bottom, axisColor)
+
+	for i := 1; i < len(points); i++ {
+		drawLine(img, x(points[i-1].Time), y(points[i-1].Value), x(points[i].Time),
+			y(points[i].Value), lineColor)
+	}
+	if len(points) == 1 {
+		fillRect(img, x(points[0].Time)-1, y(points[0].Value)-1, 3, 3, lineColor)
+	}
+
+	drawMarker(img, x(minPoint.Time), y(minPoint.Value), formatValue(minPoint.Value, precision),
+		false, left, right, minColor)
+	drawMarker(img, x(maxPoint.Time), y(maxPoint.Value), formatValue(maxPoint.Value, precision),
+		true, left, right, maxColor)
+
+	return img, nil
+}
+
+// drawMarker draws a square at (x, y) with the label above or below it kept inside [left, right]
+func drawMarker(img *image.RGBA, x, y int, label string, above bool, left, right int,
+	c color.Color) {
+	fillRect(img, x-markerSize/2, y-markerSize/2, markerSize, markerSize, c)
+
+	labelX := x - textWidth(label)/2
+	if labelX < left {
+		labelX = left
+	}
+	if labelX+textWidth(label) > right {
+		labelX = right - textWidth(label)
+	}
+	labelY := y + markerSize
+	if above {
+		labelY = y - markerSize - textHeight()
+	}
+	drawText(img, labelX, labelY, label, c)
+}
+
+func valuePrecision(valueRange float64) int {
+	precision := 2 - int(math.Floor(math.Log10(valueRange/yTicks)))
+	if precision < 0 {
+		return 0
+	}
+	return precision
+}
+
+func formatValue(value float64, precision int) string {
+	return strconv.FormatFloat(value, 'f', precision, 64)
+}
+
+func fillRect(img *image.RGBA, x, y, width, height int, c color.Color) {
+	draw.Draw(img, image.Rect(x, y, x+width, y+height), &image.Uniform{C: c}, image.Point{},
+		draw.Src)
+}
+
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.Color) {
+	dx := abs(x1 - x0)
+	dy := -abs(y1 - y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	e := dx + dy
+	for {
+		img.Set(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,138 @@
+package plot
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"testing"
+	"time"
+)
+
+const (
+	testWidth  = 640
+	testHeight = 360
+)
+
+var testStart = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testPoints(values ...float64) []Point {
+	points := make([]Point, 0, len(values))
+	for i, value := range values {
+		points = append(points, Point{Time: testStart.Add(time.Duration(i) * time.Hour),
+			Value: value})
+	}
+	return points
+}
+
+// colorRows returns rows of the image containing pixels of color c
+func colorRows(img *image.RGBA, c color.RGBA) map[int]bool {
+	rows := make(map[int]bool)
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if img.RGBAAt(x, y) == c {
+				rows[y] = true
+			}
+		}
+	}
+	return rows
+}
+
+func TestLineChartPng(t *testing.T) {
+	img, err := LineChart(testPoints(95.5, 96.25, 94.75, 95), testWidth, testHeight)
+	if err != nil {
+		t.Fatalf("line chart: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode png: %v", err)
+	}
+	config, err := png.DecodeConfig(&buf)
+	if err != nil {
+		t.Fatalf("decode png: %v", err)
+	}
+	if config.Width != testWidth || config.Height != testHeight {
+		t.Fatalf("png size = %dx%d, want %dx%d", config.Width, config.Height, testWidth,
+			testHeight)
+	}
+}
+
+func TestLineChartScaling(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []Point
+		// flat is set when the line must be drawn in a single row
+		flat bool
+	}{
+		{name: "flat series", points: testPoints(95, 95, 95), flat: true},
+		{name: "flat zero series", points: testPoints(0, 0), flat: true},
+		{name: "single point", points: testPoints(95.5)},
+		{name: "rising series", points: testPoints(1, 2, 3, 4)},
+		{name: "same time", points: []Point{{Time: testStart, Value: 1},
+			{Time: testStart, Value: 2}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			img, err := LineChart(test.points, testWidth, testHeight)
+			if err != nil {
+				t.Fatalf("line chart: %v", err)
+			}
+			if img.Bounds() != image.Rect(0, 0, testWidth, testHeight) {
+				t.Fatalf("bounds = %v, want %dx%d", img.Bounds(), testWidth, testHeight)
+			}
+
+			// a single point is covered by the max marker drawn the last
+			c := lineColor
+			if len(test.points) == 1 {
+				c = maxColor
+			}
+			rows := colorRows(img, c)
+			if len(rows) == 0 {
+				t.Fatalf("line is not drawn")
+			}
+			// the line is kept away from the edges where the labels are drawn
+			for row := range rows {
+				if row <= padding || row >= testHeight-padding {
+					t.Fatalf("line is drawn at row %d outside of the plot area", row)
+				}
+			}
+			if test.flat && len(rows) != 1 {
+				t.Fatalf("flat line is drawn in %d rows, want 1", len(rows))
+			}
+		})
+	}
+}
+
+func TestLineChartErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		points        []Point
+		width, height int
+		want          error
+	}{
+		{name: "empty series", points: nil, width: testWidth, height: testHeight,
+			want: ErrorNoPoints},
+		{name: "not a number", points: testPoints(1, math.NaN()), width: testWidth,
+			height: testHeight, want: ErrorInvalidPoints},
+		{name: "infinity", points: testPoints(math.Inf(1)), width: testWidth,
+			height: testHeight, want: ErrorInvalidPoints},
+		{name: "not sorted", points: []Point{{Time: testStart.Add(time.Hour)},
+			{Time: testStart}}, width: testWidth, height: testHeight, want: ErrorInvalidPoints},
+		{name: "too small", points: testPoints(1, 2), width: 40, height: 30,
+			want: ErrorInvalidSize},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			img, err := LineChart(test.points, test.width, test.height)
+			if !errors.Is(err, test.want) || img != nil {
+				t.Fatalf("line chart: got image %v error %v, want %v", img != nil, err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"golang.org/x/net/context"
+	"math/rand"
+	"time"
+)
+
+type Backoff struct {
+	Attempts  int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// DoWithBackoff calls fn until it succeeds, retryable reports false for its error or attempts
+// are exhausted. Delay between attempts grows exponentially from BaseDelay up to MaxDelay with
+// full jitter. Waiting is interrupted when ctx is done.
+func DoWithBackoff(ctx context.Context, fn func(ctx context.Context) error, backoff Backoff,
+	retryable func(err error) bool) error {
+	var err error
+	delay := backoff.BaseDelay
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if err == nil || attempt >= backoff.Attempts || !retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(time.Duration(rand.Int63n(int64(delay) + 1)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		delay = nextDelay(delay, backoff.MaxDelay)
+	}
+}
+
+// nextDelay doubles delay up to maxDelay
+func nextDelay(delay, maxDelay time.Duration) time.Duration {
+	delay *= 2
+	if delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
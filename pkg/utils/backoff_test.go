@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"errors"
+	"golang.org/x/net/context"
+	"testing"
+	"time"
+)
+
+var (
+	errorTestRetryable = errors.New("retryable")
+	errorTestPermanent = errors.New("permanent")
+)
+
+func TestNextDelayCap(t *testing.T) {
+	maxDelay := 5 * time.Second
+	want := []time.Duration{
+		2 * time.Second,
+		4 * time.Second,
+		5 * time.Second,
+		5 * time.Second,
+	}
+
+	delay := time.Second
+	for i, expected := range want {
+		delay = nextDelay(delay, maxDelay)
+		if delay != expected {
+			t.Fatalf("step %d: delay = %v, want %v", i, delay, expected)
+		}
+	}
+}
+
+func TestDoWithBackoff(t *testing.T) {
+	backoff := Backoff{
+		Attempts:  3,
+		BaseDelay: time.Millisecond,
+		MaxDelay:  2 * time.Millisecond,
+	}
+	retryable := func(err error) bool {
+		return err == errorTestRetryable
+	}
+
+	tests := []struct {
+		name     string
+		errs     []error
+		err      error
+		attempts int
+	}{
+		{name: "success", errs: []error{nil}, attempts: 1},
+		{name: "success after retries", errs: []error{errorTestRetryable, errorTestRetryable, nil},
+			attempts: 3},
+		{name: "attempts exhausted", errs: []error{errorTestRetryable, errorTestRetryable,
+			errorTestRetryable}, err: errorTestRetryable, attempts: 3},
+		{name: "permanent error", errs: []error{errorTestRetryable, errorTestPermanent},
+			err: errorTestPermanent, attempts: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attempts := 0
+			err := DoWithBackoff(context.Background(), func(ctx context.Context) error {
+				err := test.errs[attempts]
+				attempts++
+				return err
+			}, backoff, retryable)
+
+			if err != test.err {
+				t.Fatalf("error = %v, want %v", err, test.err)
+			}
+			if attempts != test.attempts {
+				t.Fatalf("attempts = %d, want %d", attempts, test.attempts)
+			}
+		})
+	}
+}
+
+func TestDoWithBackoffContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	err := DoWithBackoff(ctx, func(ctx context.Context) error {
+		attempts++
+		cancel()
+		return errorTestRetryable
+	}, Backoff{Attempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}, func(err error) bool {
+		return true
+	})
+
+	if err != context.Canceled {
+		t.Fatalf("error = %v, want %v", err, context.Canceled)
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts = %d, want 1", attempts)
+	}
+}
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"errors"
+	"golang.org/x/net/context"
+	"math"
+	"sync"
+	"time"
+)
+
+var (
+	ErrorInvalidRate  = errors.New("invalid rate of rate limiter")
+	ErrorInvalidBurst = errors.New("invalid burst of rate limiter")
+)
+
+// RateLimiter is a token bucket safe for concurrent use
+type RateLimiter struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	lastFill time.Time
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// NewRateLimiter returns limiter allowing rate events per second with bursts of burst events
+func NewRateLimiter(rate float64, burst int) (*RateLimiter, error) {
+	if rate <= 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+		return nil, ErrorInvalidRate
+	}
+	if burst <= 0 {
+		return nil, ErrorInvalidBurst
+	}
+	return &RateLimiter{
+		rate:     rate,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastFill: time.Now(),
+		now:      time.Now,
+	}, nil
+}
+
+// Wait blocks until an event is allowed or ctx is done
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		ok, delay := r.reserve()
+		if ok {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token or returns time until the next token is available, the delay is rounded up
+// so a fraction of a token missing is never reported as no delay
+func (r *RateLimiter) reserve() (bool, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.tokens += now.Sub(r.lastFill).Seconds() * r.rate
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.lastFill = now
+
+	if r.tokens >= 1 {
+		r.tokens--
+		return true, 0
+	}
+	delay := time.Duration(math.Ceil((1 - r.tokens) / r.rate * float64(time.Second)))
+	if delay < time.Nanosecond {
+		delay = time.Nanosecond
+	}
+	return false, delay
+}
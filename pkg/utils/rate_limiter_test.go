@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"errors"
+	"golang.org/x/net/context"
+	"testing"
+	"time"
+)
+
+func newTestRateLimiter(t *testing.T, rate float64, burst int) (*RateLimiter, *time.Time) {
+	t.Helper()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter, err := NewRateLimiter(rate, burst)
+	if err != nil {
+		t.Fatalf("new rate limiter: %v", err)
+	}
+	limiter.lastFill = now
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	limiter, now := newTestRateLimiter(t, 2, 2)
+
+	steps := []struct {
+		advance time.Duration
+		delay   time.Duration
+	}{
+		// burst is available at once
+		{delay: 0},
+		{delay: 0},
+		{delay: 500 * time.Millisecond},
+		{advance: 250 * time.Millisecond, delay: 250 * time.Millisecond},
+		{advance: 250 * time.Millisecond, delay: 0},
+		{delay: 500 * time.Millisecond},
+		// tokens are not accumulated above burst
+		{advance: 10 * time.Second, delay: 0},
+		{delay: 0},
+		{delay: 500 * time.Millisecond},
+	}
+	for i, step := range steps {
+		*now = now.Add(step.advance)
+		ok, delay := limiter.reserve()
+		if ok != (step.delay == 0) || delay != step.delay {
+			t.Fatalf("step %d: reserved %v delay = %v, want delay %v", i, ok, delay, step.delay)
+		}
+	}
+}
+
+func TestRateLimiterWaitContext(t *testing.T) {
+	limiter, err := NewRateLimiter(0.001, 1)
+	if err != nil {
+		t.Fatalf("new rate limiter: %v", err)
+	}
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("wait for burst: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimiterFractionalToken(t *testing.T) {
+	limiter, _ := newTestRateLimiter(t, 1e9, 1)
+	// a token is missing by less than a nanosecond of refill
+	limiter.tokens = 0.9999999
+
+	ok, delay := limiter.reserve()
+	if ok || delay <= 0 {
+		t.Fatalf("reserved %v delay = %v, want no token and positive delay", ok, delay)
+	}
+	if limiter.tokens >= 1 {
+		t.Fatalf("tokens = %v, want a token not granted", limiter.tokens)
+	}
+}
+
+func TestNewRateLimiterInvalid(t *testing.T) {
+	tests := []struct {
+		rate  float64
+		burst int
+		want  error
+	}{
+		{rate: 0, burst: 1, want: ErrorInvalidRate},
+		{rate: -1, burst: 1, want: ErrorInvalidRate},
+		{rate: 1, burst: 0, want: ErrorInvalidBurst},
+	}
+	for _, test := range tests {
+		if _, err := NewRateLimiter(test.rate, test.burst); !errors.Is(err, test.want) {
+			t.Fatalf("rate %v burst %d: error = %v, want %v", test.rate, test.burst, err,
+				test.want)
+		}
+	}
+}
@@ -1,18 +0,0 @@
-package utils
-
-import "time"
-
-func DoWithTries(fn func() error, attempts int, delay time.Duration) error {
-	var err error
-	for attempts > 0 {
-		err = fn()
-		if err != nil {
-			time.Sleep(delay)
-			attempts--
-			continue
-		}
-		return nil
-	}
-
-	return err
-}
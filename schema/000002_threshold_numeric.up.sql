@@ -0,0 +1,2 @@
+ALTER TABLE user_converter_pair_thresholds
+    ALTER COLUMN threshold TYPE numeric USING threshold::numeric;
@@ -0,0 +1,2 @@
+DROP TABLE threshold_alerts;
+DROP TYPE alert_directions;
@@ -0,0 +1,13 @@
+CREATE TYPE alert_directions as enum ('up', 'down');
+
+CREATE TABLE threshold_alerts
+(
+    id                bigserial primary key,
+    user_id           int references users (id) on delete cascade           not null,
+    converter_pair_id int references converter_pairs (id) on delete cascade not null,
+    old_exchange      numeric                                               not null,
+    new_exchange      numeric                                               not null,
+    threshold         numeric                                               not null,
+    direction         alert_directions                                      not null,
+    created_at        timestamptz                                           not null default now()
+);
@@ -0,0 +1,2 @@
+DROP INDEX exchange_rates_converter_pair_id_sampled_at;
+DROP TABLE exchange_rates;
@@ -0,0 +1,11 @@
+CREATE TABLE exchange_rates
+(
+    id                bigserial primary key,
+    converter_pair_id int references converter_pairs (id) on delete cascade not null,
+    rate              numeric                                               not null,
+    sampled_at        timestamptz                                           not null,
+    source            varchar(255)                                          not null
+);
+
+CREATE INDEX exchange_rates_converter_pair_id_sampled_at
+    ON exchange_rates (converter_pair_id, sampled_at);
@@ -0,0 +1,23 @@
+DELETE FROM converter_pairs WHERE level > 3;
+
+ALTER TABLE converter_pairs
+    ADD COLUMN first_currency_id  int references currencies (id) on delete cascade,
+    ADD COLUMN second_currency_id int references currencies (id) on delete cascade,
+    ADD COLUMN third_currency_id  int references currencies (id) on delete cascade;
+
+UPDATE converter_pairs cp
+SET first_currency_id  = (SELECT currency_id FROM converter_pair_steps s
+                          WHERE s.converter_pair_id = cp.id AND s.position = 0),
+    second_currency_id = (SELECT currency_id FROM converter_pair_steps s
+                          WHERE s.converter_pair_id = cp.id AND s.position = 1),
+    third_currency_id  = (SELECT currency_id FROM converter_pair_steps s
+                          WHERE s.converter_pair_id = cp.id AND s.position = 2);
+
+ALTER TABLE converter_pairs
+    ALTER COLUMN first_currency_id SET NOT NULL,
+    ALTER COLUMN second_currency_id SET NOT NULL;
+ALTER TABLE converter_pairs ADD UNIQUE (first_currency_id, second_currency_id, third_currency_id);
+ALTER TABLE converter_pairs DROP COLUMN route;
+
+DROP INDEX converter_pair_steps_currency_id;
+DROP TABLE converter_pair_steps;
@@ -0,0 +1,28 @@
+CREATE TABLE converter_pair_steps
+(
+    converter_pair_id int references converter_pairs (id) on delete cascade not null,
+    position          int                                                   not null,
+    currency_id       int references currencies (id) on delete cascade      not null,
+    primary key (converter_pair_id, position)
+);
+
+CREATE INDEX converter_pair_steps_currency_id ON converter_pair_steps (currency_id);
+
+INSERT INTO converter_pair_steps (converter_pair_id, position, currency_id)
+SELECT id, 0, first_currency_id FROM converter_pairs
+UNION ALL
+SELECT id, 1, second_currency_id FROM converter_pairs
+UNION ALL
+SELECT id, 2, third_currency_id FROM converter_pairs WHERE third_currency_id IS NOT NULL;
+
+-- route is a '/' separated list of currency ids of the steps, it keeps routes unique
+ALTER TABLE converter_pairs ADD COLUMN route varchar(1024);
+UPDATE converter_pairs
+SET route = concat_ws('/', first_currency_id, second_currency_id, third_currency_id);
+ALTER TABLE converter_pairs ALTER COLUMN route SET NOT NULL;
+ALTER TABLE converter_pairs ADD UNIQUE (route);
+
+ALTER TABLE converter_pairs
+    DROP COLUMN first_currency_id,
+    DROP COLUMN second_currency_id,
+    DROP COLUMN third_currency_id;
@@ -0,0 +1,3 @@
+DROP TABLE arbitrage_subscribers;
+DROP TABLE arbitrage_opportunity_steps;
+DROP TABLE arbitrage_opportunities;
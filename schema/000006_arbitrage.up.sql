@@ -0,0 +1,21 @@
+CREATE TABLE arbitrage_opportunities
+(
+    id          bigserial primary key,
+    rate        numeric     not null,
+    net_rate    numeric     not null,
+    detected_at timestamptz not null default now()
+);
+
+CREATE TABLE arbitrage_opportunity_steps
+(
+    arbitrage_opportunity_id bigint references arbitrage_opportunities (id) on delete cascade not null,
+    position                 int                                                              not null,
+    currency_id              int references currencies (id) on delete cascade                 not null,
+    primary key (arbitrage_opportunity_id, position)
+);
+
+CREATE TABLE arbitrage_subscribers
+(
+    user_id    int primary key references users (id) on delete cascade,
+    created_at timestamptz not null default now()
+);
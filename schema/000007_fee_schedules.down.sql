@@ -0,0 +1,2 @@
+DROP TABLE fee_schedules;
+DROP TYPE fee_scopes;
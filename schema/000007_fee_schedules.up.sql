@@ -0,0 +1,12 @@
+CREATE TYPE fee_scopes as enum ('bank', 'provider');
+
+CREATE TABLE fee_schedules
+(
+    id         serial primary key,
+    scope      fee_scopes   not null,
+    key        varchar(255) not null,
+    fixed      numeric      not null default 0,
+    percent    numeric      not null default 0,
+    updated_at timestamptz  not null default now(),
+    UNIQUE (scope, key)
+);
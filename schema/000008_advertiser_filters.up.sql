@@ -0,0 +1,9 @@
+CREATE TABLE user_advertiser_filters
+(
+    user_id              int primary key references users (id) on delete cascade,
+    merchant_only        boolean        not null default false,
+    min_completion_rate  numeric        not null default 0,
+    min_order_count      int            not null default 0,
+    excluded_advertisers varchar(255)[] not null default '{}',
+    updated_at           timestamptz    not null default now()
+);
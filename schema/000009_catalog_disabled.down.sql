@@ -0,0 +1,5 @@
+ALTER TABLE converter_pairs
+    DROP COLUMN disabled;
+
+ALTER TABLE currencies
+    DROP COLUMN disabled;
@@ -0,0 +1,5 @@
+ALTER TABLE currencies
+    ADD COLUMN disabled boolean not null default false;
+
+ALTER TABLE converter_pairs
+    ADD COLUMN disabled boolean not null default false;
@@ -0,0 +1,3 @@
+-- the column was added by 000007_fee_schedules before, so it may exist already
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS is_admin boolean not null default false;
@@ -0,0 +1,10 @@
+// Package schema embeds sql migrations of the user database, so the server applies them without
+// the sources
+package schema
+
+import "embed"
+
+// Migrations are versioned NNNNNN_name.up.sql files with their NNNNNN_name.down.sql rollbacks
+//
+//go:embed *.up.sql *.down.sql
+var Migrations embed.FS
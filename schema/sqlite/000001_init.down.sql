@@ -0,0 +1,14 @@
+DROP TABLE user_advertiser_filters;
+DROP TABLE fee_schedules;
+DROP TABLE arbitrage_subscribers;
+DROP TABLE arbitrage_opportunity_steps;
+DROP TABLE arbitrage_opportunities;
+DROP TABLE exchange_rates;
+DROP TABLE threshold_alerts;
+DROP TABLE user_converter_pair_thresholds;
+DROP TABLE user_converter_pairs;
+DROP TABLE user_currencies;
+DROP TABLE converter_pair_steps;
+DROP TABLE converter_pairs;
+DROP TABLE currencies;
+DROP TABLE users;
@@ -0,0 +1,136 @@
+-- sqlite has no enum types, values of postgres enums are kept by check constraints. Exchanges and
+-- fees are text to keep exact decimals, numeric affinity of sqlite would convert them to floats
+
+CREATE TABLE users
+(
+    id            integer primary key autoincrement,
+    chat_id       bigint unique,
+    user_name     varchar(255),
+    first_name    varchar(255) not null,
+    last_name     varchar(255) not null,
+    language_code varchar(3),
+    is_admin      boolean      not null default false
+);
+
+CREATE TABLE currencies
+(
+    id        integer primary key autoincrement,
+    type      varchar(7)   not null check (type in ('classic', 'crypto')),
+    code      varchar(255) not null,
+    bank_code varchar(255),
+    disabled  boolean      not null default false,
+    UNIQUE (code, type, bank_code)
+);
+
+-- route is a '/' separated list of currency ids of the steps, it keeps routes unique
+CREATE TABLE converter_pairs
+(
+    id       integer primary key autoincrement,
+    level    int           not null,
+    route    varchar(1024) not null unique,
+    disabled boolean       not null default false
+);
+
+CREATE TABLE converter_pair_steps
+(
+    converter_pair_id int references converter_pairs (id) on delete cascade not null,
+    position          int                                                   not null,
+    currency_id       int references currencies (id) on delete cascade      not null,
+    primary key (converter_pair_id, position)
+);
+
+CREATE INDEX converter_pair_steps_currency_id ON converter_pair_steps (currency_id);
+
+CREATE TABLE user_currencies
+(
+    id          integer primary key autoincrement,
+    user_id     int references users (id) on delete cascade      not null,
+    currency_id int references currencies (id) on delete cascade not null,
+    UNIQUE (user_id, currency_id)
+);
+
+CREATE TABLE user_converter_pairs
+(
+    id                integer primary key autoincrement,
+    user_id           int references users (id) on delete cascade           not null,
+    converter_pair_id int references converter_pairs (id) on delete cascade not null,
+    UNIQUE (user_id, converter_pair_id)
+);
+
+CREATE TABLE user_converter_pair_thresholds
+(
+    id                     integer primary key autoincrement,
+    user_id                int references users (id) on delete cascade                not null,
+    user_converter_pair_id int references user_converter_pairs (id) on delete cascade not null,
+    threshold              text                                                       not null,
+    UNIQUE (user_id, user_converter_pair_id, threshold)
+);
+
+CREATE TABLE threshold_alerts
+(
+    id                integer primary key autoincrement,
+    user_id           int references users (id) on delete cascade           not null,
+    converter_pair_id int references converter_pairs (id) on delete cascade not null,
+    old_exchange      text                                                  not null,
+    new_exchange      text                                                  not null,
+    threshold         text                                                  not null,
+    direction         varchar(4)                                            not null
+        check (direction in ('up', 'down')),
+    created_at        timestamp                                             not null
+);
+
+CREATE TABLE exchange_rates
+(
+    id                integer primary key autoincrement,
+    converter_pair_id int references converter_pairs (id) on delete cascade not null,
+    rate              text                                                  not null,
+    sampled_at        timestamp                                             not null,
+    source            varchar(255)                                          not null
+);
+
+CREATE INDEX exchange_rates_converter_pair_id_sampled_at
+    ON exchange_rates (converter_pair_id, sampled_at);
+
+CREATE TABLE arbitrage_opportunities
+(
+    id          integer primary key autoincrement,
+    rate        text      not null,
+    net_rate    text      not null,
+    detected_at timestamp not null
+);
+
+CREATE TABLE arbitrage_opportunity_steps
+(
+    arbitrage_opportunity_id int references arbitrage_opportunities (id) on delete cascade not null,
+    position                 int                                                           not null,
+    currency_id              int references currencies (id) on delete cascade              not null,
+    primary key (arbitrage_opportunity_id, position)
+);
+
+CREATE TABLE arbitrage_subscribers
+(
+    user_id    int primary key references users (id) on delete cascade,
+    created_at timestamp not null default current_timestamp
+);
+
+CREATE TABLE fee_schedules
+(
+    id         integer primary key autoincrement,
+    scope      varchar(8)   not null check (scope in ('bank', 'provider')),
+    key        varchar(255) not null,
+    fixed      text         not null default '0',
+    percent    text         not null default '0',
+    updated_at timestamp    not null default current_timestamp,
+    UNIQUE (scope, key)
+);
+
+-- excluded_advertisers is a json array of user numbers of advertisers
+CREATE TABLE user_advertiser_filters
+(
+    user_id              int primary key references users (id) on delete cascade,
+    merchant_only        boolean   not null default false,
+    min_completion_rate  real      not null default 0,
+    min_order_count      int       not null default 0,
+    excluded_advertisers text      not null default '[]',
+    updated_at           timestamp not null default current_timestamp
+);
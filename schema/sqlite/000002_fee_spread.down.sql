@@ -0,0 +1,2 @@
+ALTER TABLE fee_schedules
+    DROP COLUMN spread;
@@ -0,0 +1,2 @@
+ALTER TABLE fee_schedules
+    ADD COLUMN spread text not null default '0';
@@ -0,0 +1,8 @@
+// Package sqliteSchema embeds migrations of the sqlite user db, they are translated from postgres
+// migrations of the schema package
+package sqliteSchema
+
+import "embed"
+
+//go:embed *.up.sql *.down.sql
+var Migrations embed.FS
@@ -5,20 +5,21 @@ INSERT INTO currencies (type, code, bank_code) VALUES ('classic', 'KZT', 'KaspiB
 INSERT
     INTO
         converter_pairs
-        (level, first_currency_id, second_currency_id, third_currency_id)
+        (level, route)
     VALUES
-        (3, 1, 2, 3);
+        (3, '1/2/3'),
+        (2, '1/2'),
+        (2, '2/3');
 
 INSERT
-INTO
-    converter_pairs
-    (level, first_currency_id, second_currency_id)
-VALUES
-    (2, 1, 2);
-
-INSERT
-INTO
-    converter_pairs
-(level, first_currency_id, second_currency_id)
-VALUES
-    (2, 2, 3);
\ No newline at end of file
+    INTO
+        converter_pair_steps
+        (converter_pair_id, position, currency_id)
+    VALUES
+        (1, 0, 1),
+        (1, 1, 2),
+        (1, 2, 3),
+        (2, 0, 1),
+        (2, 1, 2),
+        (3, 0, 2),
+        (3, 1, 3);